@@ -20,15 +20,27 @@ import (
 	"fmt"
 	"log"
 	"net"
-
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/RHEcosystemAppEng/cluster-iq/generated/agent"
 
 	cexec "github.com/RHEcosystemAppEng/cluster-iq/internal/cloud_executors"
 	"github.com/RHEcosystemAppEng/cluster-iq/internal/config"
 	"github.com/RHEcosystemAppEng/cluster-iq/internal/credentials"
+	ciqgrpc "github.com/RHEcosystemAppEng/cluster-iq/internal/grpc"
 	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/job"
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/profiler"
 
 	ciqLogger "github.com/RHEcosystemAppEng/cluster-iq/internal/logger"
 	"go.uber.org/zap"
@@ -50,9 +62,12 @@ var (
 // AgentService represents the main structure for managing cloud executors and configuration.
 // It also embeds the gRPC server interface for handling gRPC requests.
 type AgentService struct {
-	cfg       *config.AgentConfig
-	executors map[string]cexec.CloudExecutor
-	logger    *zap.Logger
+	cfg         *config.AgentConfig
+	executorsMu sync.RWMutex
+	executors   map[string]cexec.CloudExecutor
+	logger      *zap.Logger
+	logSink     *ciqgrpc.LogSink
+	scheduler   *job.Scheduler
 	pb.UnimplementedAgentServiceServer
 }
 
@@ -68,14 +83,17 @@ func init() {
 // Parameters:
 //   - cfg: Pointer to AgentConfig containing the configuration details.
 //   - logger: Pointer to zap.Logger for logging.
+//   - logSink: LogSink every CollectLogs stream subscribes to.
 //
 // Returns:
 //   - *AgentService: A pointer to the newly created Agent instance.
-func NewAgentService(cfg *config.AgentConfig, logger *zap.Logger) *AgentService {
+func NewAgentService(cfg *config.AgentConfig, logger *zap.Logger, logSink *ciqgrpc.LogSink) *AgentService {
 	return &AgentService{
 		cfg:       cfg,
 		executors: make(map[string]cexec.CloudExecutor, 0),
 		logger:    logger,
+		logSink:   logSink,
+		scheduler: job.NewScheduler(),
 	}
 }
 
@@ -91,11 +109,27 @@ func (a *AgentService) AddExecutor(exec cexec.CloudExecutor) error {
 		return fmt.Errorf("Cannot add a nil Executor")
 	}
 
+	a.executorsMu.Lock()
+	defer a.executorsMu.Unlock()
 	a.executors[exec.GetAccountName()] = exec
 
 	return nil
 }
 
+// executorsSnapshot returns a shallow copy of the Agent's executors map,
+// safe to range over without holding executorsMu for the duration (e.g.
+// while calling the potentially slow CloudExecutor.Discover).
+func (a *AgentService) executorsSnapshot() map[string]cexec.CloudExecutor {
+	a.executorsMu.RLock()
+	defer a.executorsMu.RUnlock()
+
+	snapshot := make(map[string]cexec.CloudExecutor, len(a.executors))
+	for name, exec := range a.executors {
+		snapshot[name] = exec
+	}
+	return snapshot
+}
+
 // readCloudProviderAccounts reads cloud provider account configurations from the credentials file.
 //
 // Returns:
@@ -133,22 +167,271 @@ func (a *AgentService) createExecutors() error {
 			}
 
 		case inventory.GCPProvider: // GCP
-			a.logger.Warn("Failed to create Executor for GCP account",
-				zap.String("account", account.Name),
-				zap.String("reason", "not implemented"),
-			)
+			a.logger.Info("Creating Executor for GCP account", zap.String("account_name", account.Name))
+			exec, err := cexec.NewGCPExecutor(inventory.NewAccount("", account.Name, account.Provider, account.User, account.Key), account.ServiceAccountKey, logger)
+			if err != nil {
+				a.logger.Error("Cannot create a GCPExecutor for account", zap.String("account_name", account.Name), zap.Error(err))
+				return err
+			}
+			if err := a.AddExecutor(exec); err != nil {
+				a.logger.Error("Cannot create a GCPExecutor for account", zap.String("account_name", account.Name), zap.Error(err))
+				return err
+			}
 
 		case inventory.AzureProvider: // Azure
-			a.logger.Warn("Failed to create Executor for Azure account",
-				zap.String("account", account.Name),
-				zap.String("reason", "not implemented"),
-			)
+			a.logger.Info("Creating Executor for Azure account", zap.String("account_name", account.Name))
+			exec, err := cexec.NewAzureExecutor(inventory.NewAccount("", account.Name, account.Provider, account.User, account.Key), account.TenantID, account.ClientID, account.Environment, logger)
+			if err != nil {
+				a.logger.Error("Cannot create an AzureExecutor for account", zap.String("account_name", account.Name), zap.Error(err))
+				return err
+			}
+			if err := a.AddExecutor(exec); err != nil {
+				a.logger.Error("Cannot create an AzureExecutor for account", zap.String("account_name", account.Name), zap.Error(err))
+				return err
+			}
 
+		default:
+			a.logger.Warn("Skipping account with unrecognized provider",
+				zap.String("account_name", account.Name),
+				zap.String("provider", string(account.Provider)))
 		}
 	}
 	return nil
 }
 
+// ListInstances implements pb.AgentServiceServer. It discovers Instances
+// across every configured executor, drops those matching the Agent's
+// configured exclusion filters, narrows the result down further by the
+// request's Filter, and normalizes each Instance's Tags to the Agent's
+// tracked tag keys.
+func (a *AgentService) ListInstances(ctx context.Context, req *pb.ListInstancesRequest) (*pb.ListInstancesResponse, error) {
+	var instances []inventory.Instance
+	for _, exec := range a.executorsSnapshot() {
+		discovered, err := exec.Discover(ctx)
+		if err != nil {
+			a.logger.Error("Failed to discover instances", zap.String("account_name", exec.GetAccountName()), zap.Error(err))
+			return nil, err
+		}
+		instances = append(instances, discovered...)
+	}
+
+	instances = inventory.FilterInstances(instances, exclusionQuery(a.cfg.Tags.ExclusionFilters))
+	instances = inventory.FilterInstances(instances, queryFromTagFilter(req.GetFilter()))
+
+	resp := &pb.ListInstancesResponse{Instances: make([]*pb.Instance, 0, len(instances))}
+	for _, instance := range instances {
+		instance.Tags = inventory.NormalizeTags(instance.Tags, a.cfg.Tags.TrackedKeys)
+		resp.Instances = append(resp.Instances, toPBInstance(instance))
+	}
+
+	return resp, nil
+}
+
+// exclusionQuery builds the inventory.Query that keeps only the Instances
+// NOT matching any of the Agent's "key=value" exclusion filters.
+func exclusionQuery(filters []string) inventory.Query {
+	predicates := make([]inventory.Predicate, 0, len(filters))
+	for _, filter := range filters {
+		key, value, _ := strings.Cut(filter, "=")
+		predicates = append(predicates, inventory.Predicate{Key: key, Value: value, Negate: true})
+	}
+	return inventory.Query{Operator: inventory.OperatorAnd, Predicates: predicates}
+}
+
+// queryFromTagFilter converts a pb.TagFilter into the equivalent
+// inventory.Query. A nil filter matches every Instance.
+func queryFromTagFilter(filter *pb.TagFilter) inventory.Query {
+	if filter == nil {
+		return inventory.Query{}
+	}
+
+	predicates := make([]inventory.Predicate, 0, len(filter.GetPredicates()))
+	for _, p := range filter.GetPredicates() {
+		predicates = append(predicates, inventory.Predicate{Key: p.GetKey(), Value: p.GetValue(), Negate: p.GetNegate()})
+	}
+
+	operator := inventory.OperatorAnd
+	if filter.GetOperator() == pb.LogicalOperator_OR {
+		operator = inventory.OperatorOr
+	}
+
+	return inventory.Query{Operator: operator, Predicates: predicates}
+}
+
+// toPBInstance converts an inventory.Instance into its gRPC wire
+// representation.
+func toPBInstance(instance inventory.Instance) *pb.Instance {
+	tags := make(map[string]string, len(instance.Tags))
+	for _, tag := range instance.Tags {
+		tags[tag.Key] = tag.Value
+	}
+
+	return &pb.Instance{
+		Id:          instance.ID,
+		Name:        instance.Name,
+		Provider:    string(instance.Provider),
+		AccountName: instance.AccountName,
+		ClusterId:   instance.ClusterID,
+		ClusterName: instance.ClusterName,
+		Tags:        tags,
+	}
+}
+
+// CollectLogs implements pb.AgentServiceServer, streaming every log line the
+// Agent emits to stream until the caller disconnects.
+func (a *AgentService) CollectLogs(req *pb.CollectLogsRequest, stream pb.AgentService_CollectLogsServer) error {
+	a.logSink.Subscribe(stream.Context(), stream)
+	return nil
+}
+
+// GetJobStatus implements pb.AgentServiceServer, reporting the current
+// state of every background job the Agent's Scheduler manages.
+func (a *AgentService) GetJobStatus(ctx context.Context, req *pb.GetJobStatusRequest) (*pb.GetJobStatusResponse, error) {
+	snapshots := a.scheduler.Status()
+
+	resp := &pb.GetJobStatusResponse{Jobs: make([]*pb.JobStatus, 0, len(snapshots))}
+	for _, snapshot := range snapshots {
+		var lastError string
+		if snapshot.LastError != nil {
+			lastError = snapshot.LastError.Error()
+		}
+
+		var lastRunUnix int64
+		if !snapshot.LastRun.IsZero() {
+			lastRunUnix = snapshot.LastRun.Unix()
+		}
+
+		resp.Jobs = append(resp.Jobs, &pb.JobStatus{
+			Name:        snapshot.Name,
+			Status:      string(snapshot.Status),
+			LastRunUnix: lastRunUnix,
+			LastError:   lastError,
+		})
+	}
+
+	return resp, nil
+}
+
+// TriggerJob implements pb.AgentServiceServer, requesting an immediate,
+// out-of-band run of the named background job, bypassing its regular
+// interval.
+func (a *AgentService) TriggerJob(ctx context.Context, req *pb.TriggerJobRequest) (*pb.TriggerJobResponse, error) {
+	if err := a.scheduler.TriggerJob(req.GetName()); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	return &pb.TriggerJobResponse{}, nil
+}
+
+// registerJobs registers the Agent's periodic background jobs with its
+// Scheduler: an InventorySync and a ClusterReconcile job per configured
+// executor, plus a single CredentialsRefresh job that re-reads the
+// credentials file so rotated or newly added accounts pick up new
+// executors without restarting the Agent.
+func (a *AgentService) registerJobs() {
+	for name, exec := range a.executorsSnapshot() {
+		exec := exec
+
+		a.scheduler.Register(fmt.Sprintf("InventorySync/%s", name), a.cfg.Scheduler.InventorySyncInterval, a.cfg.Scheduler.Jitter, func(ctx context.Context) error {
+			instances, err := exec.Discover(ctx)
+			if err != nil {
+				return err
+			}
+			a.logger.Info("InventorySync completed",
+				zap.String("account_name", exec.GetAccountName()),
+				zap.Int("instances_count", len(instances)))
+			return nil
+		})
+
+		a.scheduler.Register(fmt.Sprintf("ClusterReconcile/%s", name), a.cfg.Scheduler.ClusterReconcileInterval, a.cfg.Scheduler.Jitter, func(ctx context.Context) error {
+			instances, err := exec.Discover(ctx)
+			if err != nil {
+				return err
+			}
+
+			clusters := make(map[string]struct{})
+			for _, instance := range instances {
+				clusters[instance.ClusterID] = struct{}{}
+			}
+			a.logger.Info("ClusterReconcile completed",
+				zap.String("account_name", exec.GetAccountName()),
+				zap.Int("clusters_count", len(clusters)))
+			return nil
+		})
+	}
+
+	a.scheduler.Register("CredentialsRefresh", a.cfg.Scheduler.CredentialsRefreshInterval, a.cfg.Scheduler.Jitter, func(ctx context.Context) error {
+		return a.createExecutors()
+	})
+}
+
+// registerAttestationValidators registers an AttestationValidator for every
+// cloud provider with enough trust material configured in cfg. Providers
+// left unconfigured simply never pass attestation, instead of failing
+// startup, since not every deployment attests every provider it supports.
+func registerAttestationValidators(cfg config.AttestationConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.AWSTrustBundleFile != "" {
+		pem, err := os.ReadFile(cfg.AWSTrustBundleFile)
+		if err != nil {
+			return fmt.Errorf("cannot read AWS attestation trust bundle: %w", err)
+		}
+		validator, err := ciqgrpc.NewAWSAttestationValidator(pem)
+		if err != nil {
+			return err
+		}
+		ciqgrpc.RegisterAttestationValidator(validator)
+	}
+
+	if cfg.AzureTrustBundleFile != "" {
+		pem, err := os.ReadFile(cfg.AzureTrustBundleFile)
+		if err != nil {
+			return fmt.Errorf("cannot read Azure attestation trust bundle: %w", err)
+		}
+		validator, err := ciqgrpc.NewAzureAttestationValidator(pem)
+		if err != nil {
+			return err
+		}
+		ciqgrpc.RegisterAttestationValidator(validator)
+	}
+
+	if cfg.GCPAudience != "" {
+		ciqgrpc.RegisterAttestationValidator(ciqgrpc.NewGCPAttestationValidator(cfg.GCPAudience))
+	}
+
+	return nil
+}
+
+// newProfilingSink builds the profiler.Sink configured by cfg.
+func newProfilingSink(ctx context.Context, cfg config.ProfilingConfig) (profiler.Sink, error) {
+	switch cfg.SinkType {
+	case "s3":
+		awsCfg, err := awsconfig.LoadDefaultConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load AWS SDK config for profiling sink: %w", err)
+		}
+		return profiler.NewS3Sink(s3.NewFromConfig(awsCfg), cfg.S3Bucket, cfg.S3Prefix), nil
+
+	case "http":
+		return profiler.NewHTTPSink(nil, cfg.HTTPEndpoint), nil
+
+	default: // "local"
+		return profiler.NewLocalDirSink(cfg.LocalDir), nil
+	}
+}
+
+// accountLabels builds the "account_name:provider" labels describing every
+// executor the Agent manages, attached to every profile it collects.
+func accountLabels(executors map[string]cexec.CloudExecutor) string {
+	labels := make([]string, 0, len(executors))
+	for name, exec := range executors {
+		labels = append(labels, fmt.Sprintf("%s:%s", name, exec.GetProvider()))
+	}
+	return strings.Join(labels, ",")
+}
+
 // LoggingInterceptor is a gRPC interceptor that logs information about incoming requests and their responses.
 //
 // It logs details such as the client's IP address, the invoked method, and any errors that occur during method execution.
@@ -193,6 +476,12 @@ func main() {
 	// Ignore Logger sync error
 	defer func() { _ = logger.Sync() }()
 
+	// ctx is cancelled on SIGTERM/SIGINT, so the gRPC server drains and the
+	// job scheduler stops in a well-defined order instead of being killed
+	// mid-request.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
 	var err error
 
 	// Loading AgentService configuration
@@ -201,19 +490,67 @@ func main() {
 		logger.Fatal("Failed to load Agent config", zap.Error(err))
 	}
 
+	// Registering the CollectLogs sink so every record the logger emits
+	// from this point on is also streamed to connected log collectors.
+	logSink := ciqgrpc.NewLogSink()
+	logger = ciqgrpc.WithLogSink(logger, logSink)
+
+	if err := registerAttestationValidators(cfg.Security.Attestation); err != nil {
+		logger.Fatal("Failed to register attestation validators", zap.Error(err))
+	}
+
 	// Creating AgentService with the specified configuration
-	agent := NewAgentService(cfg, logger)
+	agent := NewAgentService(cfg, logger, logSink)
 
 	// Creating Executors
 	err = agent.createExecutors()
 	if err != nil {
 		agent.logger.Panic("Error during CloudExecutors initialization", zap.Error(err))
 	} else {
-		agent.logger.Info("CloudExecutors initialization successfully", zap.Int("executors_count", len(agent.executors)))
+		agent.logger.Info("CloudExecutors initialization successfully", zap.Int("executors_count", len(agent.executorsSnapshot())))
+	}
+
+	// Registering and starting the background job scheduler. It shares
+	// main's cancellable context, so it stops draining in-flight jobs as
+	// soon as a shutdown signal arrives.
+	agent.registerJobs()
+	agent.scheduler.Start(ctx)
+
+	// Starting continuous profiling, if enabled. Profiling runs for the
+	// lifetime of the process, so it shares main's background context.
+	if cfg.Profiling.Enabled {
+		sink, err := newProfilingSink(context.Background(), cfg.Profiling)
+		if err != nil {
+			logger.Fatal("Failed to create profiling sink", zap.Error(err))
+		}
+
+		profiler.Start(context.Background(), profiler.Config{
+			Enabled:  true,
+			Interval: cfg.Profiling.Interval,
+			Sink:     sink,
+			Logger:   logger,
+			Labels: map[string]string{
+				"version":  version,
+				"commit":   commit,
+				"accounts": accountLabels(agent.executorsSnapshot()),
+			},
+		})
+
+		profiler.StartAdminServer(cfg.Profiling.AdminListenURL, logger)
+	}
+
+	// Loading the mutual TLS credentials the gRPC server enforces on every
+	// connecting client.
+	serverCreds, err := ciqgrpc.LoadServerCredentials(cfg.Security.TLS)
+	if err != nil {
+		logger.Fatal("Failed to load gRPC server TLS credentials", zap.Error(err))
 	}
 
 	// Initializing gRPC server
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(LoggingInterceptor))
+	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
+		grpc.ChainUnaryInterceptor(LoggingInterceptor, ciqgrpc.AttestationInterceptor(cfg.Security.Attestation)),
+	)
 	reflection.Register(grpcServer)
 
 	// Registering Agent service on gRPC server
@@ -229,8 +566,23 @@ func main() {
 		zap.String("listen_url", agent.cfg.ListenURL),
 		zap.String("version", version),
 		zap.String("commit", commit))
-	// Serving gRPC
-	if err := grpcServer.Serve(lis); err != nil {
+
+	// Serving gRPC in the background, so this goroutine is free to watch
+	// for a shutdown signal.
+	serveErrCh := make(chan error, 1)
+	go func() {
+		serveErrCh <- grpcServer.Serve(lis)
+	}()
+
+	<-ctx.Done()
+	logger.Info("Shutdown signal received, draining gRPC server and background jobs")
+
+	// GracefulStop first, so in-flight RPCs finish before the job
+	// scheduler's own in-flight runs are given the chance to drain.
+	grpcServer.GracefulStop()
+	agent.scheduler.Wait()
+
+	if err := <-serveErrCh; err != nil {
 		logger.Fatal("failed to start server", zap.Error(err))
 	}
 	logger.Info("ClusterIQ Agent Finished")
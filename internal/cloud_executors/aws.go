@@ -0,0 +1,97 @@
+package cloud_executors
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"go.uber.org/zap"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+// AWSExecutor implements CloudExecutor for Amazon Web Services accounts. It
+// lists EC2 instances via the AWS SDK and resolves their OpenShift cluster
+// identity from the `kubernetes.io/cluster/<name>-<infraID>` tag convention.
+type AWSExecutor struct {
+	account inventory.Account
+	client  *ec2.Client
+	logger  *zap.Logger
+}
+
+// NewAWSExecutor returns a new AWSExecutor for the given Account.
+//
+// Parameters:
+//   - account: the Account to discover Instances for. account.User and
+//     account.Key are used as the AWS access key ID and secret access key.
+//   - logger: shared logging instance.
+//
+// Returns:
+//   - *AWSExecutor: the newly created executor.
+func NewAWSExecutor(account inventory.Account, logger *zap.Logger) *AWSExecutor {
+	awsCfg, err := config.LoadDefaultConfig(context.Background(),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(account.User, account.Key, "")),
+	)
+	if err != nil {
+		logger.Error("Failed to load AWS SDK config", zap.String("account_name", account.Name), zap.Error(err))
+	}
+
+	return &AWSExecutor{
+		account: account,
+		client:  ec2.NewFromConfig(awsCfg),
+		logger:  logger,
+	}
+}
+
+// GetAccountName returns the name of the Account this executor was created
+// for.
+func (e *AWSExecutor) GetAccountName() string {
+	return e.account.Name
+}
+
+// GetProvider returns inventory.AWSProvider.
+func (e *AWSExecutor) GetProvider() inventory.Provider {
+	return inventory.AWSProvider
+}
+
+// Discover lists every EC2 instance reachable with the executor's
+// credentials and converts it into an inventory.Instance.
+func (e *AWSExecutor) Discover(ctx context.Context) ([]inventory.Instance, error) {
+	var instances []inventory.Instance
+
+	paginator := ec2.NewDescribeInstancesPaginator(e.client, &ec2.DescribeInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			e.logger.Error("Failed to list EC2 instances", zap.String("account_name", e.account.Name), zap.Error(err))
+			return nil, err
+		}
+
+		for _, reservation := range page.Reservations {
+			for _, awsInstance := range reservation.Instances {
+				instances = append(instances, inventory.NewInstance(
+					aws.ToString(awsInstance.InstanceId),
+					aws.ToString(awsInstance.InstanceId),
+					inventory.AWSProvider,
+					e.account.Name,
+					tagsFromAWS(awsInstance.Tags),
+				))
+			}
+		}
+	}
+
+	return instances, nil
+}
+
+// tagsFromAWS converts AWS-native EC2 tags into ClusterIQ's generic Tag
+// model.
+func tagsFromAWS(awsTags []ec2types.Tag) []inventory.Tag {
+	tags := make([]inventory.Tag, 0, len(awsTags))
+	for _, t := range awsTags {
+		tags = append(tags, *inventory.NewTag(aws.ToString(t.Key), aws.ToString(t.Value), ""))
+	}
+	return tags
+}
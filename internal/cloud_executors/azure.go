@@ -0,0 +1,145 @@
+package cloud_executors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"go.uber.org/zap"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/credentials"
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+// azureEnvironments maps a credentials.AzureEnvironment selector to its
+// corresponding azcore.Cloud configuration.
+var azureEnvironments = map[credentials.AzureEnvironment]cloud.Configuration{
+	credentials.AzurePublicCloud:       cloud.AzurePublic,
+	credentials.AzureUSGovernmentCloud: cloud.AzureGovernment,
+	credentials.AzureChinaCloud:        cloud.AzureChina,
+}
+
+// AzureExecutor implements CloudExecutor for Microsoft Azure subscriptions.
+// It lists virtual machines via the Azure Resource Manager Compute API and
+// resolves their OpenShift cluster identity from the resource tags
+// `openshift-installer` and `cluster-api-provider-azure` attach to every
+// instance they create.
+type AzureExecutor struct {
+	account        inventory.Account
+	subscriptionID string
+	client         *armcompute.VirtualMachinesClient
+	logger         *zap.Logger
+}
+
+// NewAzureExecutor returns a new AzureExecutor for the given Account.
+//
+// Parameters:
+//   - account: the Account to discover Instances for. account.User is used
+//     as the Azure subscription ID, account.Key as the AAD application
+//     client secret.
+//   - tenantID: Azure Active Directory tenant ID to authenticate against.
+//   - clientID: Azure Active Directory application (client) ID.
+//   - environment: selects which Azure cloud instance to target, allowing
+//     sovereign clouds such as AzureUSGovernmentCloud. Defaults to
+//     credentials.AzurePublicCloud when empty.
+//   - logger: shared logging instance.
+//
+// Returns:
+//   - *AzureExecutor: the newly created executor.
+//   - error: an error if the Azure credentials or client cannot be created.
+func NewAzureExecutor(account inventory.Account, tenantID string, clientID string, environment credentials.AzureEnvironment, logger *zap.Logger) (*AzureExecutor, error) {
+	if environment == "" {
+		environment = credentials.AzurePublicCloud
+	}
+
+	cloudCfg, ok := azureEnvironments[environment]
+	if !ok {
+		return nil, fmt.Errorf("unknown Azure environment %q for account %q", environment, account.Name)
+	}
+
+	cred, err := azidentity.NewClientSecretCredential(tenantID, clientID, account.Key, &azidentity.ClientSecretCredentialOptions{
+		ClientOptions: azcore.ClientOptions{Cloud: cloudCfg},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create Azure credential for account %q: %w", account.Name, err)
+	}
+
+	client, err := armcompute.NewVirtualMachinesClient(account.User, cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{Cloud: cloudCfg},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create Azure Compute client for account %q: %w", account.Name, err)
+	}
+
+	return &AzureExecutor{
+		account:        account,
+		subscriptionID: account.User,
+		client:         client,
+		logger:         logger,
+	}, nil
+}
+
+// GetAccountName returns the name of the Account this executor was created
+// for.
+func (e *AzureExecutor) GetAccountName() string {
+	return e.account.Name
+}
+
+// GetProvider returns inventory.AzureProvider.
+func (e *AzureExecutor) GetProvider() inventory.Provider {
+	return inventory.AzureProvider
+}
+
+// Discover lists every virtual machine across all resource groups of the
+// executor's subscription and converts it into an inventory.Instance.
+func (e *AzureExecutor) Discover(ctx context.Context) ([]inventory.Instance, error) {
+	var instances []inventory.Instance
+
+	pager := e.client.NewListAllPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			e.logger.Error("Failed to list Azure virtual machines", zap.String("account_name", e.account.Name), zap.Error(err))
+			return nil, err
+		}
+
+		for _, vm := range page.Value {
+			instances = append(instances, inventory.NewInstance(
+				derefString(vm.ID),
+				derefString(vm.Name),
+				inventory.AzureProvider,
+				e.account.Name,
+				tagsFromAzure(vm.Tags),
+			))
+		}
+	}
+
+	return instances, nil
+}
+
+// derefString returns *s, or "" if s is nil, since Azure SDK responses
+// represent optional string fields as pointers that aren't always
+// populated.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// tagsFromAzure converts Azure resource tags into ClusterIQ's generic Tag
+// model.
+func tagsFromAzure(azureTags map[string]*string) []inventory.Tag {
+	tags := make([]inventory.Tag, 0, len(azureTags))
+	for key, value := range azureTags {
+		if value == nil {
+			continue
+		}
+		tags = append(tags, *inventory.NewTag(key, *value, ""))
+	}
+	return tags
+}
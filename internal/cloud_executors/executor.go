@@ -0,0 +1,28 @@
+// Package cloud_executors provides the CloudExecutor abstraction used by the
+// ClusterIQ Agent to discover inventory on the different cloud providers it
+// supports (AWS, GCP and Azure), exposing a single interface regardless of
+// which provider-native SDK is used underneath.
+package cloud_executors
+
+import (
+	"context"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+// CloudExecutor is implemented by every provider-specific executor
+// (AWSExecutor, GCPExecutor, AzureExecutor, ...). The AgentService holds one
+// CloudExecutor per configured Account and dispatches to it transparently,
+// without needing to know which cloud provider it talks to.
+type CloudExecutor interface {
+	// GetAccountName returns the name of the Account this executor was
+	// created for.
+	GetAccountName() string
+
+	// GetProvider returns the cloud Provider this executor talks to.
+	GetProvider() inventory.Provider
+
+	// Discover scans the Account for Instances and returns them along with
+	// their resolved cluster identity.
+	Discover(ctx context.Context) ([]inventory.Instance, error)
+}
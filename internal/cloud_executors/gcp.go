@@ -0,0 +1,107 @@
+package cloud_executors
+
+import (
+	"context"
+	"fmt"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"go.uber.org/zap"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+// GCPExecutor implements CloudExecutor for Google Cloud Platform projects.
+// It lists GCE instances via the Compute Engine API and resolves their
+// OpenShift cluster identity from the resource labels `openshift-installer`
+// attaches to every instance it creates.
+type GCPExecutor struct {
+	account   inventory.Account
+	projectID string
+	client    *compute.InstancesClient
+	logger    *zap.Logger
+}
+
+// NewGCPExecutor returns a new GCPExecutor for the given Account.
+//
+// Parameters:
+//   - account: the Account to discover Instances for. account.User is used
+//     as the GCP project ID.
+//   - serviceAccountKey: raw GCP service account JSON key used to
+//     authenticate, as declared in the credentials file.
+//   - logger: shared logging instance.
+//
+// Returns:
+//   - *GCPExecutor: the newly created executor.
+//   - error: an error if the Compute Engine client cannot be created.
+func NewGCPExecutor(account inventory.Account, serviceAccountKey []byte, logger *zap.Logger) (*GCPExecutor, error) {
+	client, err := compute.NewInstancesRESTClient(context.Background(), option.WithCredentialsJSON(serviceAccountKey))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create GCP Compute Engine client for account %q: %w", account.Name, err)
+	}
+
+	return &GCPExecutor{
+		account:   account,
+		projectID: account.User,
+		client:    client,
+		logger:    logger,
+	}, nil
+}
+
+// GetAccountName returns the name of the Account this executor was created
+// for.
+func (e *GCPExecutor) GetAccountName() string {
+	return e.account.Name
+}
+
+// GetProvider returns inventory.GCPProvider.
+func (e *GCPExecutor) GetProvider() inventory.Provider {
+	return inventory.GCPProvider
+}
+
+// Discover lists every GCE instance across all zones of the executor's
+// project and converts it into an inventory.Instance.
+func (e *GCPExecutor) Discover(ctx context.Context) ([]inventory.Instance, error) {
+	var instances []inventory.Instance
+
+	it := e.client.AggregatedList(ctx, &computepb.AggregatedListInstancesRequest{
+		Project: e.projectID,
+	})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			e.logger.Error("Failed to list GCE instances", zap.String("account_name", e.account.Name), zap.Error(err))
+			return nil, err
+		}
+
+		for _, gcpInstance := range pair.Value.Instances {
+			instances = append(instances, inventory.NewInstance(
+				fmt.Sprintf("%d", gcpInstance.GetId()),
+				gcpInstance.GetName(),
+				inventory.GCPProvider,
+				e.account.Name,
+				tagsFromGCPLabels(gcpInstance.GetLabels()),
+			))
+		}
+	}
+
+	return instances, nil
+}
+
+// tagsFromGCPLabels converts GCE resource labels into ClusterIQ's generic
+// Tag model. GCP labels cannot contain the `/` or `.` characters used by the
+// AWS and Azure cluster tag conventions, so `openshift-installer` encodes
+// them as `kubernetes-io-cluster-<name>`; GCPClusterTagParser understands
+// this convention.
+func tagsFromGCPLabels(labels map[string]string) []inventory.Tag {
+	tags := make([]inventory.Tag, 0, len(labels))
+	for key, value := range labels {
+		tags = append(tags, *inventory.NewTag(key, value, ""))
+	}
+	return tags
+}
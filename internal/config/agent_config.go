@@ -0,0 +1,277 @@
+// Package config loads and validates the configuration used by the
+// ClusterIQ Agent.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// defaultListenURL is used when AGENT_LISTEN_URL is not set.
+const defaultListenURL = "0.0.0.0:8080"
+
+// defaultProfilingInterval is used when PROFILING_INTERVAL is not set.
+const defaultProfilingInterval = time.Minute
+
+// defaultProfilingAdminListenURL is used when PROFILING_ADMIN_LISTEN_URL is
+// not set.
+const defaultProfilingAdminListenURL = "0.0.0.0:6060"
+
+// defaultInventorySyncInterval is used when INVENTORY_SYNC_INTERVAL is not
+// set.
+const defaultInventorySyncInterval = 5 * time.Minute
+
+// defaultClusterReconcileInterval is used when CLUSTER_RECONCILE_INTERVAL
+// is not set.
+const defaultClusterReconcileInterval = 15 * time.Minute
+
+// defaultCredentialsRefreshInterval is used when
+// CREDENTIALS_REFRESH_INTERVAL is not set.
+const defaultCredentialsRefreshInterval = 24 * time.Hour
+
+// defaultJobJitter is used when JOB_JITTER is not set.
+const defaultJobJitter = 30 * time.Second
+
+// CredentialsConfig groups the settings needed to locate the cloud provider
+// credentials file.
+type CredentialsConfig struct {
+	// CredentialsFile is the filesystem path to the YAML file declaring the
+	// Agent's cloud provider accounts.
+	CredentialsFile string
+}
+
+// TagsConfig declares which instance tags the Agent should track and which
+// instances it should silently skip during discovery.
+type TagsConfig struct {
+	// TrackedKeys lists the tag keys the Agent keeps in
+	// inventory.Instance.Tags when it returns Instances; tags with any other
+	// key, including the cluster tags ClusterTagParsers read, are left out.
+	// Cluster identity is resolved before that, so it is not affected. An
+	// empty list means every tag is tracked.
+	TrackedKeys []string
+
+	// ExclusionFilters lists tag key=value pairs that, when present on an
+	// instance, cause the Agent to drop it from discovery results (e.g.
+	// `cluster-iq.io/ignore=true`).
+	ExclusionFilters []string
+}
+
+// TLSConfig declares the certificate material used to establish mutual TLS
+// between the Agent and the ClusterIQ server.
+type TLSConfig struct {
+	// CertFile is the Agent's own certificate, presented to its gRPC peer.
+	CertFile string
+
+	// KeyFile is the private key matching CertFile.
+	KeyFile string
+
+	// CAFile is the CA bundle used to verify the peer's certificate.
+	CAFile string
+}
+
+// AttestationConfig declares whether the Agent requires attested-TLS on top
+// of mutual TLS, and which cloud accounts are allowed to complete it.
+type AttestationConfig struct {
+	// Enabled turns on attested-TLS. When false, only mutual TLS is
+	// enforced.
+	Enabled bool
+
+	// AllowedAccountIDs lists the cloud account IDs whose Instances are
+	// allowed to connect.
+	AllowedAccountIDs []string
+
+	// AWSTrustBundleFile is the PEM file containing the AWS certificate
+	// used to verify PKCS7-signed Instance Identity Documents.
+	AWSTrustBundleFile string
+
+	// AzureTrustBundleFile is the PEM file containing the Azure certificate
+	// chain used to verify PKCS7-signed IMDS attested documents.
+	AzureTrustBundleFile string
+
+	// GCPAudience is the audience GCP instance identity JWTs must be issued
+	// for.
+	GCPAudience string
+}
+
+// ProfilingConfig declares the Agent's opt-in continuous profiling
+// subsystem.
+type ProfilingConfig struct {
+	// Enabled turns on continuous profiling.
+	Enabled bool
+
+	// Interval is how often a round of CPU, heap, goroutine and mutex
+	// profiles is collected and uploaded.
+	Interval time.Duration
+
+	// AdminListenURL is the address the ad-hoc net/http/pprof debugging
+	// server listens on.
+	AdminListenURL string
+
+	// SinkType selects where collected profiles are uploaded to: "local",
+	// "s3" or "http".
+	SinkType string
+
+	// LocalDir is the directory profiles are written to when SinkType is
+	// "local".
+	LocalDir string
+
+	// S3Bucket and S3Prefix locate where profiles are uploaded when
+	// SinkType is "s3".
+	S3Bucket string
+	S3Prefix string
+
+	// HTTPEndpoint is the collector URL profiles are POSTed to when
+	// SinkType is "http".
+	HTTPEndpoint string
+}
+
+// SchedulerConfig declares how often the Agent's background jobs run.
+type SchedulerConfig struct {
+	// InventorySyncInterval is how often the Agent re-discovers Instances
+	// across its configured accounts.
+	InventorySyncInterval time.Duration
+
+	// ClusterReconcileInterval is how often the Agent re-discovers each
+	// account's Instances and counts the distinct clusters they belong to.
+	ClusterReconcileInterval time.Duration
+
+	// CredentialsRefreshInterval is how often the Agent re-reads its
+	// credentials file, picking up rotated or newly added accounts.
+	CredentialsRefreshInterval time.Duration
+
+	// Jitter bounds the random delay added to every scheduled job run, so
+	// that jobs registered with the same interval don't all fire in
+	// lockstep.
+	Jitter time.Duration
+}
+
+// SecurityConfig groups the Agent's transport security settings.
+type SecurityConfig struct {
+	// TLS holds the mutual TLS certificate material.
+	TLS TLSConfig
+
+	// Attestation holds the attested-TLS settings.
+	Attestation AttestationConfig
+}
+
+// AgentConfig holds every configuration value the ClusterIQ Agent needs at
+// startup.
+type AgentConfig struct {
+	// ListenURL is the address the Agent's gRPC server listens on.
+	ListenURL string
+
+	// Credentials groups the cloud provider credentials settings.
+	Credentials CredentialsConfig
+
+	// Tags groups the user-defined tag tracking and filtering settings.
+	Tags TagsConfig
+
+	// Security groups the Agent's transport security settings.
+	Security SecurityConfig
+
+	// Profiling groups the Agent's continuous profiling settings.
+	Profiling ProfilingConfig
+
+	// Scheduler groups the Agent's background job interval settings.
+	Scheduler SchedulerConfig
+}
+
+// LoadAgentConfig builds an AgentConfig from the Agent's environment
+// variables.
+//
+// Returns:
+//   - *AgentConfig: the loaded configuration.
+//   - error: an error if a required environment variable is missing.
+func LoadAgentConfig() (*AgentConfig, error) {
+	credentialsFile := os.Getenv("CREDENTIALS_FILE")
+	if credentialsFile == "" {
+		return nil, fmt.Errorf("CREDENTIALS_FILE environment variable must be set")
+	}
+
+	listenURL := os.Getenv("AGENT_LISTEN_URL")
+	if listenURL == "" {
+		listenURL = defaultListenURL
+	}
+
+	return &AgentConfig{
+		ListenURL: listenURL,
+		Credentials: CredentialsConfig{
+			CredentialsFile: credentialsFile,
+		},
+		Tags: TagsConfig{
+			TrackedKeys:      splitAndTrim(os.Getenv("TRACKED_TAG_KEYS")),
+			ExclusionFilters: splitAndTrim(os.Getenv("TAG_EXCLUSION_FILTERS")),
+		},
+		Security: SecurityConfig{
+			TLS: TLSConfig{
+				CertFile: os.Getenv("AGENT_TLS_CERT_FILE"),
+				KeyFile:  os.Getenv("AGENT_TLS_KEY_FILE"),
+				CAFile:   os.Getenv("AGENT_TLS_CA_FILE"),
+			},
+			Attestation: AttestationConfig{
+				Enabled:              os.Getenv("AGENT_ATTESTATION_ENABLED") == "true",
+				AllowedAccountIDs:    splitAndTrim(os.Getenv("AGENT_ATTESTATION_ALLOWED_ACCOUNTS")),
+				AWSTrustBundleFile:   os.Getenv("AGENT_ATTESTATION_AWS_TRUST_BUNDLE"),
+				AzureTrustBundleFile: os.Getenv("AGENT_ATTESTATION_AZURE_TRUST_BUNDLE"),
+				GCPAudience:          os.Getenv("AGENT_ATTESTATION_GCP_AUDIENCE"),
+			},
+		},
+		Profiling: ProfilingConfig{
+			Enabled:        os.Getenv("PROFILING_ENABLED") == "true",
+			Interval:       durationOrDefault(os.Getenv("PROFILING_INTERVAL"), defaultProfilingInterval),
+			AdminListenURL: stringOrDefault(os.Getenv("PROFILING_ADMIN_LISTEN_URL"), defaultProfilingAdminListenURL),
+			SinkType:       stringOrDefault(os.Getenv("PROFILING_SINK_TYPE"), "local"),
+			LocalDir:       os.Getenv("PROFILING_LOCAL_DIR"),
+			S3Bucket:       os.Getenv("PROFILING_S3_BUCKET"),
+			S3Prefix:       os.Getenv("PROFILING_S3_PREFIX"),
+			HTTPEndpoint:   os.Getenv("PROFILING_HTTP_ENDPOINT"),
+		},
+		Scheduler: SchedulerConfig{
+			InventorySyncInterval:      durationOrDefault(os.Getenv("INVENTORY_SYNC_INTERVAL"), defaultInventorySyncInterval),
+			ClusterReconcileInterval:   durationOrDefault(os.Getenv("CLUSTER_RECONCILE_INTERVAL"), defaultClusterReconcileInterval),
+			CredentialsRefreshInterval: durationOrDefault(os.Getenv("CREDENTIALS_REFRESH_INTERVAL"), defaultCredentialsRefreshInterval),
+			Jitter:                     durationOrDefault(os.Getenv("JOB_JITTER"), defaultJobJitter),
+		},
+	}, nil
+}
+
+// splitAndTrim splits a comma-separated environment variable value into its
+// trimmed, non-empty elements.
+func splitAndTrim(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	var result []string
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// stringOrDefault returns value, or fallback when value is empty.
+func stringOrDefault(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// durationOrDefault parses value as a time.Duration, returning fallback
+// when value is empty or malformed.
+func durationOrDefault(value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
@@ -0,0 +1,191 @@
+// Package credentials handles loading and parsing the Agent's cloud
+// provider credentials file, which declares one AccountConfig per cloud
+// account the Agent should create a CloudExecutor for.
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+	"gopkg.in/yaml.v3"
+)
+
+// AzureEnvironment identifies which Azure cloud instance an Azure
+// AccountConfig should authenticate against, allowing Agents to target
+// sovereign clouds instead of only Azure Public Cloud.
+type AzureEnvironment string
+
+const (
+	// AzurePublicCloud is the default, globally available Azure cloud.
+	AzurePublicCloud AzureEnvironment = "AzurePublicCloud"
+	// AzureUSGovernmentCloud is the Azure cloud instance for US government workloads.
+	AzureUSGovernmentCloud AzureEnvironment = "AzureUSGovernmentCloud"
+	// AzureChinaCloud is the Azure cloud instance operated in China.
+	AzureChinaCloud AzureEnvironment = "AzureChinaCloud"
+)
+
+// AccountConfig represents a single cloud provider account as declared in
+// the Agent's credentials file.
+type AccountConfig struct {
+	// Name is the human-readable name of the Account.
+	Name string `yaml:"name" json:"name"`
+
+	// Provider is the cloud platform this Account belongs to.
+	Provider inventory.Provider `yaml:"provider" json:"provider"`
+
+	// User identifies the account to the provider: AWS access key ID,
+	// Azure subscription ID, or GCP project ID. Azure authenticates with
+	// ClientID and Key and GCP with ServiceAccountKey rather than User; for
+	// both, User names the subscription or project their API calls run
+	// against.
+	User string `yaml:"user,omitempty" json:"user,omitempty"`
+
+	// Key is the credential secret associated with User (AWS secret access
+	// key, Azure client secret, ...).
+	Key string `yaml:"key,omitempty" json:"key,omitempty"`
+
+	// Environment selects which Azure cloud instance to authenticate
+	// against. Only meaningful when Provider is inventory.AzureProvider; it
+	// defaults to AzurePublicCloud when empty.
+	Environment AzureEnvironment `yaml:"environment,omitempty" json:"environment,omitempty"`
+
+	// TenantID is the Azure Active Directory tenant to authenticate
+	// against. Only meaningful when Provider is inventory.AzureProvider.
+	TenantID string `yaml:"tenantId,omitempty" json:"tenantId,omitempty"`
+
+	// ClientID is the Azure Active Directory application (client) ID used
+	// alongside Key to authenticate. Only meaningful when Provider is
+	// inventory.AzureProvider.
+	ClientID string `yaml:"clientId,omitempty" json:"clientId,omitempty"`
+
+	// ServiceAccountKey holds the raw GCP service account JSON key used to
+	// authenticate. Only meaningful when Provider is inventory.GCPProvider.
+	ServiceAccountKey json.RawMessage `yaml:"serviceAccountKey,omitempty" json:"serviceAccountKey,omitempty"`
+}
+
+// accountConfigAlias mirrors AccountConfig but decodes ServiceAccountKey as
+// a raw yaml.Node, since yaml.v3 has no built-in support for unmarshaling a
+// nested YAML mapping into json.RawMessage.
+type accountConfigAlias struct {
+	Name              string             `yaml:"name"`
+	Provider          inventory.Provider `yaml:"provider"`
+	User              string             `yaml:"user,omitempty"`
+	Key               string             `yaml:"key,omitempty"`
+	Environment       AzureEnvironment   `yaml:"environment,omitempty"`
+	TenantID          string             `yaml:"tenantId,omitempty"`
+	ClientID          string             `yaml:"clientId,omitempty"`
+	ServiceAccountKey yaml.Node          `yaml:"serviceAccountKey,omitempty"`
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It decodes ServiceAccountKey
+// from its natural YAML representation — a nested mapping, e.g. a GCP
+// service account JSON key pasted in as-is under `serviceAccountKey:` —
+// and re-encodes it as the JSON bytes AccountConfig.ServiceAccountKey
+// expects, since yaml.v3 cannot unmarshal a mapping directly into
+// json.RawMessage.
+func (c *AccountConfig) UnmarshalYAML(value *yaml.Node) error {
+	var alias accountConfigAlias
+	if err := value.Decode(&alias); err != nil {
+		return err
+	}
+
+	provider, err := normalizeProvider(alias.Provider)
+	if err != nil {
+		return fmt.Errorf("account %q: %w", alias.Name, err)
+	}
+
+	*c = AccountConfig{
+		Name:        alias.Name,
+		Provider:    provider,
+		User:        alias.User,
+		Key:         alias.Key,
+		Environment: alias.Environment,
+		TenantID:    alias.TenantID,
+		ClientID:    alias.ClientID,
+	}
+
+	if alias.ServiceAccountKey.Kind != 0 {
+		var raw interface{}
+		if err := alias.ServiceAccountKey.Decode(&raw); err != nil {
+			return fmt.Errorf("cannot decode serviceAccountKey: %w", err)
+		}
+
+		encoded, err := json.Marshal(raw)
+		if err != nil {
+			return fmt.Errorf("cannot encode serviceAccountKey as JSON: %w", err)
+		}
+		c.ServiceAccountKey = encoded
+	}
+
+	return nil
+}
+
+// ReadCloudAccounts reads and parses the credentials file at path, returning
+// one AccountConfig per declared cloud account.
+//
+// Parameters:
+//   - path: filesystem path to the YAML credentials file.
+//
+// Returns:
+//   - []AccountConfig: the parsed account configurations.
+//   - error: an error if the file cannot be read or parsed.
+func ReadCloudAccounts(path string) ([]AccountConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read credentials file %q: %w", path, err)
+	}
+
+	var accounts []AccountConfig
+	if err := yaml.Unmarshal(data, &accounts); err != nil {
+		return nil, fmt.Errorf("cannot parse credentials file %q: %w", path, err)
+	}
+
+	for _, account := range accounts {
+		if err := validateAccount(account); err != nil {
+			return nil, fmt.Errorf("invalid credentials file %q: %w", path, err)
+		}
+	}
+
+	return accounts, nil
+}
+
+// normalizeProvider canonicalizes p's case (e.g. "gcp", "Gcp" and "GCP" all
+// become inventory.GCPProvider), so a credentials file author doesn't have
+// to match the exact constant spelling.
+func normalizeProvider(p inventory.Provider) (inventory.Provider, error) {
+	switch strings.ToUpper(string(p)) {
+	case strings.ToUpper(string(inventory.AWSProvider)):
+		return inventory.AWSProvider, nil
+	case strings.ToUpper(string(inventory.GCPProvider)):
+		return inventory.GCPProvider, nil
+	case strings.ToUpper(string(inventory.AzureProvider)):
+		return inventory.AzureProvider, nil
+	default:
+		return "", fmt.Errorf("unknown provider %q", p)
+	}
+}
+
+// validateAccount checks that account carries the fields its Provider
+// requires, so a misconfigured credentials file fails loudly at startup
+// instead of producing a CloudExecutor that silently discovers nothing.
+func validateAccount(account AccountConfig) error {
+	switch account.Provider {
+	case inventory.GCPProvider:
+		if account.User == "" {
+			return fmt.Errorf("account %q: GCP accounts require \"user\" to be set to the project ID", account.Name)
+		}
+		if len(account.ServiceAccountKey) == 0 {
+			return fmt.Errorf("account %q: GCP accounts require \"serviceAccountKey\"", account.Name)
+		}
+
+	case inventory.AzureProvider:
+		if account.TenantID == "" || account.ClientID == "" {
+			return fmt.Errorf("account %q: Azure accounts require \"tenantId\" and \"clientId\"", account.Name)
+		}
+	}
+
+	return nil
+}
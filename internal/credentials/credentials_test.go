@@ -0,0 +1,102 @@
+package credentials
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+func writeCredentialsFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "credentials.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("cannot write test credentials file: %v", err)
+	}
+	return path
+}
+
+func TestReadCloudAccountsGCPServiceAccountKeyAsNestedMapping(t *testing.T) {
+	const credentialsYAML = `
+- name: my-gcp-account
+  provider: gcp
+  user: my-project
+  serviceAccountKey:
+    type: service_account
+    project_id: my-project
+    private_key_id: abc123
+`
+
+	path := writeCredentialsFile(t, credentialsYAML)
+
+	accounts, err := ReadCloudAccounts(path)
+	if err != nil {
+		t.Fatalf("ReadCloudAccounts returned error: %v", err)
+	}
+	if len(accounts) != 1 {
+		t.Fatalf("expected 1 account, got %d", len(accounts))
+	}
+
+	if accounts[0].Provider != inventory.GCPProvider {
+		t.Fatalf("expected provider %q, got %q", inventory.GCPProvider, accounts[0].Provider)
+	}
+	if accounts[0].User != "my-project" {
+		t.Fatalf("expected user %q, got %q", "my-project", accounts[0].User)
+	}
+
+	var key map[string]string
+	if err := json.Unmarshal(accounts[0].ServiceAccountKey, &key); err != nil {
+		t.Fatalf("ServiceAccountKey is not valid JSON: %v", err)
+	}
+
+	if key["project_id"] != "my-project" {
+		t.Fatalf("expected project_id %q, got %q", "my-project", key["project_id"])
+	}
+}
+
+func TestReadCloudAccountsProviderCaseInsensitive(t *testing.T) {
+	path := writeCredentialsFile(t, `
+- name: my-aws-account
+  provider: aws
+  user: AKIAEXAMPLE
+  key: secret
+`)
+
+	accounts, err := ReadCloudAccounts(path)
+	if err != nil {
+		t.Fatalf("ReadCloudAccounts returned error: %v", err)
+	}
+	if len(accounts) != 1 {
+		t.Fatalf("expected 1 account, got %d", len(accounts))
+	}
+	if accounts[0].Provider != inventory.AWSProvider {
+		t.Fatalf("expected provider %q, got %q", inventory.AWSProvider, accounts[0].Provider)
+	}
+}
+
+func TestReadCloudAccountsUnknownProvider(t *testing.T) {
+	path := writeCredentialsFile(t, `
+- name: my-account
+  provider: openstack
+`)
+
+	if _, err := ReadCloudAccounts(path); err == nil {
+		t.Fatal("expected an error for an unrecognized provider")
+	}
+}
+
+func TestReadCloudAccountsGCPRequiresUser(t *testing.T) {
+	path := writeCredentialsFile(t, `
+- name: my-gcp-account
+  provider: gcp
+  serviceAccountKey:
+    type: service_account
+    project_id: my-project
+`)
+
+	if _, err := ReadCloudAccounts(path); err == nil {
+		t.Fatal("expected an error for a GCP account missing \"user\"")
+	}
+}
@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/config"
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+// Metadata keys a client must set to present its IdentityDocument during
+// the attested-TLS handshake.
+const (
+	identityProviderMetadataKey = "x-identity-provider"
+	identityDocumentMetadataKey = "x-identity-document"
+)
+
+// IdentityDocument is the provider-native, signed proof of identity an
+// Agent presents on top of mutual TLS: the AWS Instance Identity Document,
+// the GCP instance identity JWT, or the Azure IMDS attested document.
+type IdentityDocument struct {
+	// Provider identifies which AttestationValidator should verify Raw.
+	Provider inventory.Provider
+
+	// Raw is the signed identity document as presented by the cloud
+	// provider's metadata service, unparsed.
+	Raw []byte
+}
+
+// AttestationValidator verifies an IdentityDocument's signature, one
+// implementation per inventory.Provider.
+type AttestationValidator interface {
+	// Provider returns the inventory.Provider this validator handles.
+	Provider() inventory.Provider
+
+	// Validate verifies doc's signature and returns the account
+	// identifier extracted from the verified document body (never from
+	// caller-supplied metadata), so it can be checked against the
+	// allow-list. It returns an error if the signature does not check
+	// out.
+	Validate(ctx context.Context, doc IdentityDocument) (accountID string, err error)
+}
+
+// attestationValidators holds the registered AttestationValidator for every
+// supported Provider.
+var attestationValidators = map[inventory.Provider]AttestationValidator{}
+
+// RegisterAttestationValidator registers validator for its Provider,
+// replacing any validator previously registered for it. The Agent calls it
+// at startup for every provider it has trust material configured for.
+func RegisterAttestationValidator(validator AttestationValidator) {
+	attestationValidators[validator.Provider()] = validator
+}
+
+// Attest verifies doc's signature using the AttestationValidator registered
+// for its Provider, then checks the account identifier the validator
+// extracted from the verified document body against cfg's allow-list.
+func Attest(ctx context.Context, cfg config.AttestationConfig, doc IdentityDocument) error {
+	validator, ok := attestationValidators[doc.Provider]
+	if !ok {
+		return fmt.Errorf("no attestation validator registered for provider %q", doc.Provider)
+	}
+
+	accountID, err := validator.Validate(ctx, doc)
+	if err != nil {
+		return fmt.Errorf("identity document validation failed: %w", err)
+	}
+
+	for _, allowed := range cfg.AllowedAccountIDs {
+		if allowed == accountID {
+			return nil
+		}
+	}
+	return fmt.Errorf("account %q is not in the attestation allow-list", accountID)
+}
+
+// AttestationInterceptor returns a unary server interceptor enforcing
+// attested-TLS on top of the mutual TLS handshake already performed by the
+// transport: every call must carry a valid IdentityDocument in its
+// metadata. When cfg is disabled, every call is let through unchanged.
+func AttestationInterceptor(cfg config.AttestationConfig) ggrpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *ggrpc.UnaryServerInfo, handler ggrpc.UnaryHandler) (interface{}, error) {
+		if !cfg.Enabled {
+			return handler(ctx, req)
+		}
+
+		doc, err := identityDocumentFromContext(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "missing identity document: %v", err)
+		}
+
+		if err := Attest(ctx, cfg, doc); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "attestation failed: %v", err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// identityDocumentFromContext extracts the IdentityDocument a client
+// presented through gRPC metadata.
+func identityDocumentFromContext(ctx context.Context) (IdentityDocument, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return IdentityDocument{}, fmt.Errorf("no gRPC metadata in context")
+	}
+
+	provider := firstMetadataValue(md, identityProviderMetadataKey)
+	raw := firstMetadataValue(md, identityDocumentMetadataKey)
+	if provider == "" || raw == "" {
+		return IdentityDocument{}, fmt.Errorf("%s and %s metadata are required", identityProviderMetadataKey, identityDocumentMetadataKey)
+	}
+
+	return IdentityDocument{
+		Provider: inventory.Provider(provider),
+		Raw:      []byte(raw),
+	}, nil
+}
+
+// firstMetadataValue returns the first value set for key, or an empty
+// string if key is absent.
+func firstMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
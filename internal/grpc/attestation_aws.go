@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+
+	"go.mozilla.org/pkcs7"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+// awsIdentityDocument is the subset of fields ClusterIQ cares about in the
+// JSON body of an AWS Instance Identity Document.
+type awsIdentityDocument struct {
+	AccountID string `json:"accountId"`
+}
+
+// awsAttestationValidator verifies the PKCS7-signed AWS Instance Identity
+// Document an Agent presents during the attested-TLS handshake, served by
+// the `dynamic/instance-identity/pkcs7` instance metadata endpoint.
+type awsAttestationValidator struct {
+	trustedCerts *x509.CertPool
+}
+
+// NewAWSAttestationValidator returns an AttestationValidator for AWS,
+// trusting signatures chaining up to a certificate in trustedCertsPEM (AWS
+// publishes this certificate per-region for this exact purpose).
+func NewAWSAttestationValidator(trustedCertsPEM []byte) (AttestationValidator, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(trustedCertsPEM) {
+		return nil, fmt.Errorf("no valid certificates found in AWS attestation trust bundle")
+	}
+	return &awsAttestationValidator{trustedCerts: pool}, nil
+}
+
+// Provider returns inventory.AWSProvider.
+func (v *awsAttestationValidator) Provider() inventory.Provider {
+	return inventory.AWSProvider
+}
+
+// Validate verifies doc.Raw as a PKCS7-signed document chaining up to the
+// validator's trusted certificates, then extracts the account ID from the
+// verified document body rather than trusting any caller-supplied value.
+func (v *awsAttestationValidator) Validate(ctx context.Context, doc IdentityDocument) (string, error) {
+	p7, err := pkcs7.Parse(doc.Raw)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse AWS PKCS7 identity document: %w", err)
+	}
+
+	if err := p7.VerifyWithChain(v.trustedCerts); err != nil {
+		return "", fmt.Errorf("AWS identity document signature verification failed: %w", err)
+	}
+
+	var iid awsIdentityDocument
+	if err := json.Unmarshal(p7.Content, &iid); err != nil {
+		return "", fmt.Errorf("cannot parse AWS identity document body: %w", err)
+	}
+
+	return iid.AccountID, nil
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+
+	"go.mozilla.org/pkcs7"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+// azureAttestedDocument is the subset of fields ClusterIQ cares about in
+// the JSON body of an Azure IMDS attested document.
+type azureAttestedDocument struct {
+	SubscriptionID string `json:"subscriptionId"`
+}
+
+// azureAttestationValidator verifies the PKCS7-signed Azure IMDS attested
+// document an Agent presents during the attested-TLS handshake, obtained
+// from the `instance/attested/document` metadata endpoint.
+type azureAttestationValidator struct {
+	trustedCerts *x509.CertPool
+}
+
+// NewAzureAttestationValidator returns an AttestationValidator for Azure,
+// trusting signatures chaining up to a certificate in trustedCertsPEM
+// (Azure publishes its IMDS signing certificate chain for this purpose).
+func NewAzureAttestationValidator(trustedCertsPEM []byte) (AttestationValidator, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(trustedCertsPEM) {
+		return nil, fmt.Errorf("no valid certificates found in Azure attestation trust bundle")
+	}
+	return &azureAttestationValidator{trustedCerts: pool}, nil
+}
+
+// Provider returns inventory.AzureProvider.
+func (v *azureAttestationValidator) Provider() inventory.Provider {
+	return inventory.AzureProvider
+}
+
+// Validate verifies doc.Raw as a PKCS7-signed document chaining up to the
+// validator's trusted certificates, then extracts the subscription ID from
+// the verified document body rather than trusting any caller-supplied
+// value.
+func (v *azureAttestationValidator) Validate(ctx context.Context, doc IdentityDocument) (string, error) {
+	p7, err := pkcs7.Parse(doc.Raw)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse Azure PKCS7 attested document: %w", err)
+	}
+
+	if err := p7.VerifyWithChain(v.trustedCerts); err != nil {
+		return "", fmt.Errorf("Azure attested document signature verification failed: %w", err)
+	}
+
+	var attested azureAttestedDocument
+	if err := json.Unmarshal(p7.Content, &attested); err != nil {
+		return "", fmt.Errorf("cannot parse Azure attested document body: %w", err)
+	}
+
+	return attested.SubscriptionID, nil
+}
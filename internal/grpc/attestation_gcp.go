@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/idtoken"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+// gcpAttestationValidator verifies the signed GCP instance identity JWT an
+// Agent presents during the attested-TLS handshake, obtained from the
+// `instance/service-accounts/default/identity` metadata endpoint.
+type gcpAttestationValidator struct {
+	audience string
+}
+
+// NewGCPAttestationValidator returns an AttestationValidator for GCP,
+// requiring identity tokens minted for audience.
+func NewGCPAttestationValidator(audience string) AttestationValidator {
+	return &gcpAttestationValidator{audience: audience}
+}
+
+// Provider returns inventory.GCPProvider.
+func (v *gcpAttestationValidator) Provider() inventory.Provider {
+	return inventory.GCPProvider
+}
+
+// Validate verifies doc.Raw as a GCP instance identity JWT issued for the
+// validator's audience, then extracts the project ID from the token's
+// verified "google.compute_engine" claim rather than trusting any
+// caller-supplied value.
+func (v *gcpAttestationValidator) Validate(ctx context.Context, doc IdentityDocument) (string, error) {
+	payload, err := idtoken.Validate(ctx, string(doc.Raw), v.audience)
+	if err != nil {
+		return "", fmt.Errorf("GCP identity token validation failed: %w", err)
+	}
+
+	projectID, err := gcpProjectIDFromClaims(payload.Claims)
+	if err != nil {
+		return "", fmt.Errorf("GCP identity token missing project ID claim: %w", err)
+	}
+	return projectID, nil
+}
+
+// gcpProjectIDFromClaims extracts "google.compute_engine.project_id" from
+// a validated GCP instance identity token's claims.
+func gcpProjectIDFromClaims(claims map[string]interface{}) (string, error) {
+	google, ok := claims["google"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("missing \"google\" claim")
+	}
+
+	computeEngine, ok := google["compute_engine"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("missing \"google.compute_engine\" claim")
+	}
+
+	projectID, ok := computeEngine["project_id"].(string)
+	if !ok || projectID == "" {
+		return "", fmt.Errorf("missing \"google.compute_engine.project_id\" claim")
+	}
+
+	return projectID, nil
+}
@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/config"
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/inventory"
+)
+
+// fakeProvider is a test-only inventory.Provider registered against a
+// fakeValidator, so tests don't depend on (or mutate) the registrations of
+// the real AWS/Azure/GCP validators.
+const fakeProvider inventory.Provider = "fake"
+
+// fakeValidator is a stub AttestationValidator that returns a fixed
+// accountID, or err if set, without doing any real signature verification.
+type fakeValidator struct {
+	accountID string
+	err       error
+}
+
+func (v *fakeValidator) Provider() inventory.Provider { return fakeProvider }
+
+func (v *fakeValidator) Validate(ctx context.Context, doc IdentityDocument) (string, error) {
+	if v.err != nil {
+		return "", v.err
+	}
+	return v.accountID, nil
+}
+
+func TestAttestAllowsAccountOnAllowList(t *testing.T) {
+	RegisterAttestationValidator(&fakeValidator{accountID: "123456789012"})
+
+	cfg := config.AttestationConfig{Enabled: true, AllowedAccountIDs: []string{"123456789012"}}
+	doc := IdentityDocument{Provider: fakeProvider, Raw: []byte("doc")}
+
+	if err := Attest(context.Background(), cfg, doc); err != nil {
+		t.Fatalf("expected account on the allow-list to be attested, got error: %v", err)
+	}
+}
+
+func TestAttestRejectsAccountNotOnAllowList(t *testing.T) {
+	RegisterAttestationValidator(&fakeValidator{accountID: "999999999999"})
+
+	cfg := config.AttestationConfig{Enabled: true, AllowedAccountIDs: []string{"123456789012"}}
+	doc := IdentityDocument{Provider: fakeProvider, Raw: []byte("doc")}
+
+	if err := Attest(context.Background(), cfg, doc); err == nil {
+		t.Fatal("expected an error for an account not on the allow-list")
+	}
+}
+
+func TestAttestRejectsInvalidSignature(t *testing.T) {
+	RegisterAttestationValidator(&fakeValidator{err: errors.New("signature verification failed")})
+
+	cfg := config.AttestationConfig{Enabled: true, AllowedAccountIDs: []string{"123456789012"}}
+	doc := IdentityDocument{Provider: fakeProvider, Raw: []byte("doc")}
+
+	if err := Attest(context.Background(), cfg, doc); err == nil {
+		t.Fatal("expected an error for a document that fails signature validation")
+	}
+}
+
+func TestAttestRejectsUnregisteredProvider(t *testing.T) {
+	cfg := config.AttestationConfig{Enabled: true}
+	doc := IdentityDocument{Provider: inventory.Provider("unregistered"), Raw: []byte("doc")}
+
+	if err := Attest(context.Background(), cfg, doc); err == nil {
+		t.Fatal("expected an error for a provider with no registered validator")
+	}
+}
+
+func TestAttestationInterceptorPassesThroughWhenDisabled(t *testing.T) {
+	interceptor := AttestationInterceptor(config.AttestationConfig{Enabled: false})
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	}
+
+	resp, err := interceptor(context.Background(), nil, &ggrpc.UnaryServerInfo{}, handler)
+	if err != nil {
+		t.Fatalf("expected no error when attestation is disabled, got: %v", err)
+	}
+	if !called {
+		t.Fatal("expected the handler to be called when attestation is disabled")
+	}
+	if resp != "ok" {
+		t.Fatalf("expected the handler's response to be returned, got %v", resp)
+	}
+}
+
+func TestAttestationInterceptorRejectsMissingIdentityDocument(t *testing.T) {
+	interceptor := AttestationInterceptor(config.AttestationConfig{Enabled: true})
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler must not be called when the identity document is missing")
+		return nil, nil
+	}
+
+	if _, err := interceptor(context.Background(), nil, &ggrpc.UnaryServerInfo{}, handler); err == nil {
+		t.Fatal("expected an error when the request carries no identity document")
+	}
+}
+
+func TestAttestationInterceptorRejectsAccountNotOnAllowList(t *testing.T) {
+	RegisterAttestationValidator(&fakeValidator{accountID: "999999999999"})
+
+	cfg := config.AttestationConfig{Enabled: true, AllowedAccountIDs: []string{"123456789012"}}
+	interceptor := AttestationInterceptor(cfg)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler must not be called when attestation fails")
+		return nil, nil
+	}
+
+	md := metadata.Pairs(identityProviderMetadataKey, string(fakeProvider), identityDocumentMetadataKey, "doc")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	if _, err := interceptor(ctx, nil, &ggrpc.UnaryServerInfo{}, handler); err == nil {
+		t.Fatal("expected an error when the attested account is not on the allow-list")
+	}
+}
+
+func TestAttestationInterceptorAllowsAttestedAccount(t *testing.T) {
+	RegisterAttestationValidator(&fakeValidator{accountID: "123456789012"})
+
+	cfg := config.AttestationConfig{Enabled: true, AllowedAccountIDs: []string{"123456789012"}}
+	interceptor := AttestationInterceptor(cfg)
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	}
+
+	md := metadata.Pairs(identityProviderMetadataKey, string(fakeProvider), identityDocumentMetadataKey, "doc")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	if _, err := interceptor(ctx, nil, &ggrpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatalf("expected the attested account to be allowed, got error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected the handler to be called for an attested, allow-listed account")
+	}
+}
@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/config"
+)
+
+// Dialer opens gRPC client connections secured with mutual TLS, reused by
+// every component (CLI, server) that talks to a ClusterIQ Agent so the
+// secure transport is configured in exactly one place.
+type Dialer struct {
+	creds credentials.TransportCredentials
+}
+
+// NewDialer returns a Dialer using the mutual TLS credentials described by
+// cfg.
+//
+// Returns:
+//   - *Dialer: the newly created Dialer.
+//   - error: an error if the TLS credentials cannot be loaded.
+func NewDialer(cfg config.TLSConfig) (*Dialer, error) {
+	creds, err := LoadClientCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Dialer{creds: creds}, nil
+}
+
+// Dial opens a secure gRPC connection to target.
+func (d *Dialer) Dial(ctx context.Context, target string) (*ggrpc.ClientConn, error) {
+	return ggrpc.DialContext(ctx, target, ggrpc.WithTransportCredentials(d.creds), ggrpc.WithBlock())
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	pb "github.com/RHEcosystemAppEng/cluster-iq/generated/agent"
+)
+
+// logSinkReservedKeys are the standard zap JSON encoder keys left out of
+// LogLine.Fields: "level", "msg" and "ts" are promoted to LogLine's
+// dedicated fields, while "logger", "caller" and "stacktrace" are dropped.
+var logSinkReservedKeys = map[string]bool{
+	"level": true, "ts": true, "msg": true, "logger": true, "caller": true, "stacktrace": true,
+}
+
+// LogSink is an io.Writer zap can be configured to write encoded log lines
+// to, fanning each line out to every CollectLogs stream currently
+// subscribed to it.
+type LogSink struct {
+	mu      sync.Mutex
+	streams map[int]pb.AgentService_CollectLogsServer
+	nextID  int
+}
+
+// NewLogSink returns an empty LogSink.
+func NewLogSink() *LogSink {
+	return &LogSink{streams: make(map[int]pb.AgentService_CollectLogsServer)}
+}
+
+// Write implements io.Writer, broadcasting p to every subscribed stream. It
+// never returns an error: a slow or disconnected collector must not break
+// the Agent's logging.
+func (s *LogSink) Write(p []byte) (int, error) {
+	line := decodeLogLine(p)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, stream := range s.streams {
+		if err := stream.Send(line); err != nil {
+			delete(s.streams, id)
+		}
+	}
+
+	return len(p), nil
+}
+
+// decodeLogLine parses a JSON-encoded zap log record (as produced by the
+// core WithLogSink wires up) into its structured pb.LogLine
+// representation, so collectors can filter by level and read fields
+// directly instead of re-parsing an opaque blob embedded in Message.
+func decodeLogLine(p []byte) *pb.LogLine {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(p, &raw); err != nil {
+		// Not a JSON-encoded record: carry it verbatim rather than drop it.
+		return &pb.LogLine{Message: string(p)}
+	}
+
+	line := &pb.LogLine{Fields: make(map[string]string, len(raw))}
+	if level, ok := raw["level"].(string); ok {
+		line.Level = level
+	}
+	if msg, ok := raw["msg"].(string); ok {
+		line.Message = msg
+	}
+	// zap's production encoder config writes "ts" as epoch seconds (a
+	// float64), not nanoseconds, so it must be scaled up rather than cast
+	// directly.
+	if ts, ok := raw["ts"].(float64); ok {
+		line.TimestampUnixNano = int64(ts * float64(time.Second))
+	}
+
+	for key, value := range raw {
+		if logSinkReservedKeys[key] {
+			continue
+		}
+		line.Fields[key] = fmt.Sprintf("%v", value)
+	}
+
+	return line
+}
+
+// Subscribe registers stream to receive every log line written to the
+// LogSink from now on, until ctx is done.
+func (s *LogSink) Subscribe(ctx context.Context, stream pb.AgentService_CollectLogsServer) {
+	s.mu.Lock()
+	id := s.nextID
+	s.nextID++
+	s.streams[id] = stream
+	s.mu.Unlock()
+
+	<-ctx.Done()
+
+	s.mu.Lock()
+	delete(s.streams, id)
+	s.mu.Unlock()
+}
+
+// WithLogSink returns a copy of logger that also writes every log record to
+// sink, so every log line the Agent emits is also streamed to connected
+// CollectLogs collectors.
+func WithLogSink(logger *zap.Logger, sink *LogSink) *zap.Logger {
+	return logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		sinkCore := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(sink), zap.NewAtomicLevelAt(zap.InfoLevel))
+		return zapcore.NewTee(core, sinkCore)
+	}))
+}
@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecodeLogLineExtractsReservedFields(t *testing.T) {
+	line := decodeLogLine([]byte(`{"level":"info","ts":1700000000.5,"msg":"hello","logger":"agent","caller":"agent.go:1","account_name":"my-account"}`))
+
+	if line.Level != "info" {
+		t.Fatalf("expected level %q, got %q", "info", line.Level)
+	}
+	if line.Message != "hello" {
+		t.Fatalf("expected message %q, got %q", "hello", line.Message)
+	}
+
+	wantNanos := int64(1700000000.5 * float64(time.Second))
+	if line.TimestampUnixNano != wantNanos {
+		t.Fatalf("expected timestamp %d, got %d", wantNanos, line.TimestampUnixNano)
+	}
+
+	if _, ok := line.Fields["logger"]; ok {
+		t.Fatal("expected reserved key \"logger\" to be excluded from Fields")
+	}
+	if _, ok := line.Fields["level"]; ok {
+		t.Fatal("expected reserved key \"level\" to be excluded from Fields")
+	}
+
+	if line.Fields["account_name"] != "my-account" {
+		t.Fatalf("expected field account_name %q, got %q", "my-account", line.Fields["account_name"])
+	}
+}
+
+func TestDecodeLogLineCarriesNonJSONVerbatim(t *testing.T) {
+	line := decodeLogLine([]byte("not json"))
+
+	if line.Message != "not json" {
+		t.Fatalf("expected the raw line to be carried as Message, got %q", line.Message)
+	}
+	if line.Level != "" {
+		t.Fatalf("expected no level for a non-JSON line, got %q", line.Level)
+	}
+}
@@ -0,0 +1,72 @@
+// Package grpc provides the secure transport ClusterIQ Agents and the
+// server use to talk to each other: mutual TLS, an optional attested-TLS
+// handshake on top of it, and a log streaming subsystem built on the same
+// connection.
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+
+	"github.com/RHEcosystemAppEng/cluster-iq/internal/config"
+)
+
+// LoadServerCredentials builds gRPC transport credentials enforcing mutual
+// TLS: the server presents its own certificate and requires and verifies
+// every client certificate against cfg.CAFile.
+func LoadServerCredentials(cfg config.TLSConfig) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load server certificate: %w", err)
+	}
+
+	caPool, err := loadCAPool(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    caPool,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
+// LoadClientCredentials builds gRPC transport credentials for dialing a
+// ClusterIQ Agent or server over mutual TLS.
+func LoadClientCredentials(cfg config.TLSConfig) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load client certificate: %w", err)
+	}
+
+	caPool, err := loadCAPool(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
+// loadCAPool reads and parses the PEM-encoded CA bundle at caFile.
+func loadCAPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read CA bundle %q: %w", caFile, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no valid certificates found in CA bundle %q", caFile)
+	}
+	return pool, nil
+}
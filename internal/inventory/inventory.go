@@ -0,0 +1,118 @@
+// Package inventory defines the cloud-agnostic data model used across
+// ClusterIQ to represent accounts, instances and clusters discovered by the
+// Agent's cloud executors, independently of the cloud provider they came
+// from.
+package inventory
+
+// Provider identifies the cloud platform an Account or Instance belongs to.
+type Provider string
+
+const (
+	// AWSProvider identifies resources discovered on Amazon Web Services.
+	AWSProvider Provider = "AWS"
+	// GCPProvider identifies resources discovered on Google Cloud Platform.
+	GCPProvider Provider = "GCP"
+	// AzureProvider identifies resources discovered on Microsoft Azure.
+	AzureProvider Provider = "Azure"
+)
+
+// Account represents a cloud provider account (subscription, project or AWS
+// account) managed by a CloudExecutor, along with the Instances discovered
+// on it.
+type Account struct {
+	// ID is the internal identifier of the Account. It is populated by the
+	// server once the Account has been registered.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the Account, as configured in the
+	// Agent's credentials file.
+	Name string `json:"name"`
+
+	// Provider is the cloud platform this Account belongs to.
+	Provider Provider `json:"provider"`
+
+	// User identifies the account to the provider (AWS access key ID,
+	// Azure subscription ID, GCP project ID).
+	User string `json:"user"`
+
+	// Key is the credential secret associated with the Account. Its meaning
+	// is provider-specific (AWS secret access key, Azure client secret).
+	Key string `json:"-"`
+
+	// Instances holds the Instances discovered for this Account.
+	Instances []Instance `json:"instances,omitempty"`
+}
+
+// NewAccount returns a new Account instance.
+//
+// Parameters:
+//   - id: internal identifier of the Account. May be empty if not yet
+//     registered on the server.
+//   - name: human-readable name of the Account.
+//   - provider: cloud platform the Account belongs to.
+//   - user: credential identity used to authenticate against the provider.
+//   - key: credential secret associated with user.
+//
+// Returns:
+//   - Account: the newly created Account.
+func NewAccount(id string, name string, provider Provider, user string, key string) Account {
+	return Account{
+		ID:       id,
+		Name:     name,
+		Provider: provider,
+		User:     user,
+		Key:      key,
+	}
+}
+
+// Instance represents a single compute resource (EC2 instance, GCE instance,
+// Azure VM, ...) discovered by a CloudExecutor.
+type Instance struct {
+	// ID is the provider-native identifier of the instance.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the instance.
+	Name string `json:"name"`
+
+	// Provider is the cloud platform this Instance was discovered on.
+	Provider Provider `json:"provider"`
+
+	// AccountName is the name of the Account this Instance belongs to.
+	AccountName string `json:"account_name"`
+
+	// ClusterID is the OpenShift infrastructure ID the instance belongs to,
+	// as resolved by the relevant ClusterTagParser.
+	ClusterID string `json:"cluster_id"`
+
+	// ClusterName is the OpenShift cluster name the instance belongs to, as
+	// resolved by the relevant ClusterTagParser.
+	ClusterName string `json:"cluster_name"`
+
+	// Tags holds every tag/label attached to the instance by the cloud
+	// provider.
+	Tags []Tag `json:"tags"`
+}
+
+// NewInstance returns a new Instance instance.
+//
+// Parameters:
+//   - id: provider-native identifier of the instance.
+//   - name: human-readable name of the instance.
+//   - provider: cloud platform the instance was discovered on.
+//   - accountName: name of the Account the instance belongs to.
+//   - tags: tags/labels attached to the instance by the cloud provider.
+//
+// Returns:
+//   - Instance: the newly created Instance, with ClusterID and ClusterName
+//     resolved from tags.
+func NewInstance(id string, name string, provider Provider, accountName string, tags []Tag) Instance {
+	return Instance{
+		ID:          id,
+		Name:        name,
+		Provider:    provider,
+		AccountName: accountName,
+		ClusterID:   GetClusterIDFromTags(provider, tags),
+		ClusterName: GetClusterNameFromTags(provider, tags),
+		Tags:        tags,
+	}
+}
@@ -1,18 +1,6 @@
 package inventory
 
-import (
-	"regexp"
-	"strings"
-)
-
 const (
-	// Regular expresion for extracting the Cluster's Name configured by `openshift-installer` from AWS Tags
-	clusterNameRegexp = "kubernetes.io/cluster/(.*?)-.{5}$"
-	// Regular expresion for extracting the InfrastructureID configured by `openshift-installer` from AWS Tags
-	infraIDRegexp = "kubernetes.io/cluster/.*-(.{5}?)$"
-	// Regular expresion for extracting the InfrastructureID configured by `openshift-installer` from AWS Tags
-	clusterIDRegexp = "kubernetes.io/cluster/(.*)$"
-
 	unknownClusterNameCode = "UNKNOWN-CLUSTER"
 	unknownClusterIDCode   = "UNKNOWN-CLUSTER"
 )
@@ -34,93 +22,119 @@ func NewTag(key string, value string, instanceID string) *Tag {
 	return &Tag{Key: key, Value: value, InstanceID: instanceID}
 }
 
-// lookForTagByKey looks for a Tag based on its Key and returns a pointer to it
+// LookForTagByKey looks for a Tag based on its Key and returns a pointer to it
 func LookForTagByKey(key string, tags []Tag) *Tag {
-	var resultTag Tag
-	for _, tag := range tags {
+	for i, tag := range tags {
 		if tag.Key == key {
-			return &resultTag
+			return &tags[i]
 		}
 	}
 	return nil
 }
 
-// parseClusterName parses a Tag key to obtain the clusterName
-func parseClusterName(key string) string {
-	re := regexp.MustCompile(clusterNameRegexp)
-	res := re.FindAllStringSubmatch(key, 1)
-
-	// if there are no results, return empty string, if there are, return first match
-	if len(res) <= 0 {
-		return unknownClusterNameCode
-	}
-	return res[0][1]
-}
-
-// parseClusterName parses a Tag key to obtain the clusterName
-func parseClusterID(key string) string {
-	re := regexp.MustCompile(clusterNameRegexp)
-	res := re.FindAllStringSubmatch(key, 1)
-
-	// if there are no results, return empty string, if there are, return first match
-	if len(res) <= 0 {
-		return unknownClusterIDCode
-	}
-	return res[0][1]
-}
-
-// parseInfraID parses a Tag key to obtain the InfraID
-func parseInfraID(key string) string {
-	re := regexp.MustCompile(infraIDRegexp)
-	res := re.FindAllStringSubmatch(key, 1)
-
-	// if there are no results, return empty string, if there are, return first match
-	if len(res) <= 0 {
-		return ""
-	}
-	return res[0][1]
-}
-
 // GetOwnerFromTags looks for a tag with the key "Owner" and returns its value
 func GetOwnerFromTags(tags []Tag) string {
-	result := (LookForTagByKey("Owner", tags))
+	result := LookForTagByKey("Owner", tags)
 	if result != nil {
-		return result.Key
+		return result.Value
 	}
 	return ""
 }
 
+// GetInstanceNameFromTags looks for a tag with the key "Name" and returns its value
 func GetInstanceNameFromTags(tags []Tag) string {
-	result := (LookForTagByKey("Name", tags))
+	result := LookForTagByKey("Name", tags)
 	if result != nil {
-		return result.Key
+		return result.Value
 	}
 	return ""
 }
 
-func GetClusterIDFromTags(tags []Tag) string {
-	for _, tag := range tags {
-		if strings.Contains(tag.Key, ClusterTagKey) {
-			return parseClusterID(tag.Key)
+// ClusterTagParser extracts OpenShift cluster identity (cluster name and
+// infrastructure ID) from the cloud-native tags/labels a provider attaches
+// to its resources. Every supported Provider registers its own
+// ClusterTagParser through RegisterClusterTagParser, so adding a new
+// provider only requires implementing and registering this interface.
+type ClusterTagParser interface {
+	// IsClusterTag reports whether tag follows this provider's cluster
+	// tagging convention.
+	IsClusterTag(tag Tag) bool
+
+	// ParseClusterName extracts the cluster name out of a tag for which
+	// IsClusterTag returned true.
+	ParseClusterName(tag Tag) string
+
+	// ParseClusterID extracts the full cluster identifier (cluster name and
+	// infrastructure ID) out of a tag for which IsClusterTag returned true.
+	ParseClusterID(tag Tag) string
+
+	// ParseInfraID extracts the infrastructure ID out of a tag for which
+	// IsClusterTag returned true.
+	ParseInfraID(tag Tag) string
+}
+
+// clusterTagParsers holds the registered ClusterTagParser for every
+// supported Provider.
+var clusterTagParsers = map[Provider]ClusterTagParser{}
+
+// RegisterClusterTagParser registers parser as the ClusterTagParser used for
+// provider. It is meant to be called from the init() function of each
+// provider-specific parser implementation.
+func RegisterClusterTagParser(provider Provider, parser ClusterTagParser) {
+	clusterTagParsers[provider] = parser
+}
+
+// GetClusterTagParser returns the ClusterTagParser registered for provider,
+// if any.
+func GetClusterTagParser(provider Provider) (ClusterTagParser, bool) {
+	parser, ok := clusterTagParsers[provider]
+	return parser, ok
+}
+
+// findClusterTag returns the first tag matching provider's cluster tagging
+// convention, along with its parser, or nil if none is found or provider has
+// no registered ClusterTagParser.
+func findClusterTag(provider Provider, tags []Tag) (ClusterTagParser, *Tag) {
+	parser, ok := GetClusterTagParser(provider)
+	if !ok {
+		return nil, nil
+	}
+
+	for i, tag := range tags {
+		if parser.IsClusterTag(tag) {
+			return parser, &tags[i]
 		}
 	}
-	return unknownClusterNameCode
+	return nil, nil
 }
 
-func GetClusterNameFromTags(tags []Tag) string {
-	for _, tag := range tags {
-		if strings.Contains(tag.Key, ClusterTagKey) {
-			return parseClusterName(tag.Key)
-		}
+// GetClusterIDFromTags resolves the cluster's full identifier (cluster name
+// and infrastructure ID) from tags, using the ClusterTagParser registered
+// for provider.
+func GetClusterIDFromTags(provider Provider, tags []Tag) string {
+	parser, tag := findClusterTag(provider, tags)
+	if parser == nil {
+		return unknownClusterIDCode
 	}
-	return unknownClusterNameCode
+	return parser.ParseClusterID(*tag)
 }
 
-func GetInfraIDFromTags(tags []Tag) string {
-	for _, tag := range tags {
-		if strings.Contains(tag.Key, ClusterTagKey) {
-			return parseInfraID(tag.Key)
-		}
+// GetClusterNameFromTags resolves the cluster's name from tags, using the
+// ClusterTagParser registered for provider.
+func GetClusterNameFromTags(provider Provider, tags []Tag) string {
+	parser, tag := findClusterTag(provider, tags)
+	if parser == nil {
+		return unknownClusterNameCode
+	}
+	return parser.ParseClusterName(*tag)
+}
+
+// GetInfraIDFromTags resolves the cluster's infrastructure ID from tags,
+// using the ClusterTagParser registered for provider.
+func GetInfraIDFromTags(provider Provider, tags []Tag) string {
+	parser, tag := findClusterTag(provider, tags)
+	if parser == nil {
+		return unknownClusterNameCode
 	}
-	return unknownClusterNameCode
+	return parser.ParseInfraID(*tag)
 }
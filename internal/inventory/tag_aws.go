@@ -0,0 +1,73 @@
+package inventory
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// awsClusterTagKey is the substring every AWS cluster tag key contains,
+	// as set by `openshift-installer`.
+	awsClusterTagKey = "kubernetes.io/cluster/"
+
+	// awsClusterNameRegexp extracts the Cluster's Name configured by
+	// `openshift-installer` from an AWS tag key.
+	awsClusterNameRegexp = `kubernetes\.io/cluster/(.*?)-.{5}$`
+	// awsInfraIDRegexp extracts the InfrastructureID configured by
+	// `openshift-installer` from an AWS tag key.
+	awsInfraIDRegexp = `kubernetes\.io/cluster/.*-(.{5}?)$`
+	// awsClusterIDRegexp extracts the full cluster identifier (cluster name
+	// and InfrastructureID) configured by `openshift-installer` from an AWS
+	// tag key.
+	awsClusterIDRegexp = `kubernetes\.io/cluster/(.*)$`
+)
+
+// awsClusterTagParser implements ClusterTagParser for the
+// `kubernetes.io/cluster/<name>-<infraID>` tag convention `openshift-installer`
+// uses on AWS.
+type awsClusterTagParser struct {
+	clusterNameRegexp *regexp.Regexp
+	clusterIDRegexp   *regexp.Regexp
+	infraIDRegexp     *regexp.Regexp
+}
+
+func init() {
+	RegisterClusterTagParser(AWSProvider, &awsClusterTagParser{
+		clusterNameRegexp: regexp.MustCompile(awsClusterNameRegexp),
+		clusterIDRegexp:   regexp.MustCompile(awsClusterIDRegexp),
+		infraIDRegexp:     regexp.MustCompile(awsInfraIDRegexp),
+	})
+}
+
+// IsClusterTag reports whether tag's key contains the AWS cluster tag
+// convention.
+func (p *awsClusterTagParser) IsClusterTag(tag Tag) bool {
+	return strings.Contains(tag.Key, awsClusterTagKey)
+}
+
+// ParseClusterName extracts the cluster name out of tag's key.
+func (p *awsClusterTagParser) ParseClusterName(tag Tag) string {
+	res := p.clusterNameRegexp.FindStringSubmatch(tag.Key)
+	if res == nil {
+		return unknownClusterNameCode
+	}
+	return res[1]
+}
+
+// ParseClusterID extracts the full cluster identifier out of tag's key.
+func (p *awsClusterTagParser) ParseClusterID(tag Tag) string {
+	res := p.clusterIDRegexp.FindStringSubmatch(tag.Key)
+	if res == nil {
+		return unknownClusterIDCode
+	}
+	return res[1]
+}
+
+// ParseInfraID extracts the infrastructure ID out of tag's key.
+func (p *awsClusterTagParser) ParseInfraID(tag Tag) string {
+	res := p.infraIDRegexp.FindStringSubmatch(tag.Key)
+	if res == nil {
+		return ""
+	}
+	return res[1]
+}
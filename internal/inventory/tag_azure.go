@@ -0,0 +1,73 @@
+package inventory
+
+import (
+	"regexp"
+)
+
+const (
+	// azureInstallerTagRegexp extracts the Cluster's Name from the
+	// `kubernetes.io_cluster.<name>` tag `openshift-installer` sets on
+	// every Azure resource it creates. Azure tag keys cannot contain `/`
+	// or `:`, so the AWS `kubernetes.io/cluster/<name>` convention is
+	// sanitized into `kubernetes.io_cluster.<name>`.
+	azureInstallerTagRegexp = `^kubernetes\.io_cluster\.(.*)$`
+
+	// azureCAPITagRegexp extracts the Cluster's Name from the
+	// `sigs.k8s.io_cluster-api-provider-azure_cluster_<name>` tag
+	// cluster-api-provider-azure sets on every Azure resource it creates.
+	azureCAPITagRegexp = `^sigs\.k8s\.io_cluster-api-provider-azure_cluster_(.*)$`
+)
+
+// azureClusterTagParser implements ClusterTagParser for the two tag
+// conventions used to identify OpenShift clusters on Azure: the
+// `openshift-installer` `kubernetes.io_cluster.<name>` tag, and the
+// `cluster-api-provider-azure`
+// `sigs.k8s.io_cluster-api-provider-azure_cluster_<name>` tag.
+//
+// Unlike AWS and GCP, neither convention encodes a separate infrastructure
+// ID: the cluster name is the full identifier.
+type azureClusterTagParser struct {
+	installerRegexp *regexp.Regexp
+	capiRegexp      *regexp.Regexp
+}
+
+func init() {
+	RegisterClusterTagParser(AzureProvider, &azureClusterTagParser{
+		installerRegexp: regexp.MustCompile(azureInstallerTagRegexp),
+		capiRegexp:      regexp.MustCompile(azureCAPITagRegexp),
+	})
+}
+
+// IsClusterTag reports whether tag's key follows either Azure cluster tag
+// convention.
+func (p *azureClusterTagParser) IsClusterTag(tag Tag) bool {
+	return p.installerRegexp.MatchString(tag.Key) || p.capiRegexp.MatchString(tag.Key)
+}
+
+// ParseClusterName extracts the cluster name out of tag's key.
+func (p *azureClusterTagParser) ParseClusterName(tag Tag) string {
+	if res := p.installerRegexp.FindStringSubmatch(tag.Key); res != nil {
+		return res[1]
+	}
+	if res := p.capiRegexp.FindStringSubmatch(tag.Key); res != nil {
+		return res[1]
+	}
+	return unknownClusterNameCode
+}
+
+// ParseClusterID extracts the full cluster identifier out of tag's key. On
+// Azure this is the same as the cluster name, since neither tag convention
+// encodes a separate infrastructure ID.
+func (p *azureClusterTagParser) ParseClusterID(tag Tag) string {
+	name := p.ParseClusterName(tag)
+	if name == unknownClusterNameCode {
+		return unknownClusterIDCode
+	}
+	return name
+}
+
+// ParseInfraID always returns an empty string: Azure's cluster tag
+// conventions do not encode a separate infrastructure ID.
+func (p *azureClusterTagParser) ParseInfraID(tag Tag) string {
+	return ""
+}
@@ -0,0 +1,75 @@
+package inventory
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// gcpClusterLabelKey is the prefix every GCP cluster label key starts
+	// with, as set by `openshift-installer`. GCP labels cannot contain `/`
+	// or `.`, so the AWS `kubernetes.io/cluster/` convention is sanitized
+	// into `kubernetes-io-cluster-`.
+	gcpClusterLabelKey = "kubernetes-io-cluster-"
+
+	// gcpClusterIDRegexp extracts the full cluster identifier (cluster name
+	// and InfrastructureID) configured by `openshift-installer` from a GCP
+	// `google_compute_instance` label key.
+	gcpClusterIDRegexp = `kubernetes-io-cluster-(.*)$`
+	// gcpClusterNameRegexp extracts the Cluster's Name configured by
+	// `openshift-installer` from a GCP label key.
+	gcpClusterNameRegexp = `kubernetes-io-cluster-(.*?)-.{5}$`
+	// gcpInfraIDRegexp extracts the InfrastructureID configured by
+	// `openshift-installer` from a GCP label key.
+	gcpInfraIDRegexp = `kubernetes-io-cluster-.*-(.{5}?)$`
+)
+
+// gcpClusterTagParser implements ClusterTagParser for the
+// `kubernetes-io-cluster-<name>-<infraID>` label convention
+// `openshift-installer` uses on GCP.
+type gcpClusterTagParser struct {
+	clusterIDRegexp   *regexp.Regexp
+	clusterNameRegexp *regexp.Regexp
+	infraIDRegexp     *regexp.Regexp
+}
+
+func init() {
+	RegisterClusterTagParser(GCPProvider, &gcpClusterTagParser{
+		clusterIDRegexp:   regexp.MustCompile(gcpClusterIDRegexp),
+		clusterNameRegexp: regexp.MustCompile(gcpClusterNameRegexp),
+		infraIDRegexp:     regexp.MustCompile(gcpInfraIDRegexp),
+	})
+}
+
+// IsClusterTag reports whether tag's key follows the GCP cluster label
+// convention.
+func (p *gcpClusterTagParser) IsClusterTag(tag Tag) bool {
+	return strings.HasPrefix(tag.Key, gcpClusterLabelKey)
+}
+
+// ParseClusterName extracts the cluster name out of tag's key.
+func (p *gcpClusterTagParser) ParseClusterName(tag Tag) string {
+	res := p.clusterNameRegexp.FindStringSubmatch(tag.Key)
+	if res == nil {
+		return unknownClusterNameCode
+	}
+	return res[1]
+}
+
+// ParseClusterID extracts the full cluster identifier out of tag's key.
+func (p *gcpClusterTagParser) ParseClusterID(tag Tag) string {
+	res := p.clusterIDRegexp.FindStringSubmatch(tag.Key)
+	if res == nil {
+		return unknownClusterIDCode
+	}
+	return res[1]
+}
+
+// ParseInfraID extracts the infrastructure ID out of tag's key.
+func (p *gcpClusterTagParser) ParseInfraID(tag Tag) string {
+	res := p.infraIDRegexp.FindStringSubmatch(tag.Key)
+	if res == nil {
+		return ""
+	}
+	return res[1]
+}
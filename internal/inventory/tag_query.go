@@ -0,0 +1,106 @@
+package inventory
+
+// LogicalOperator combines multiple Predicates into a single Query.
+type LogicalOperator int
+
+const (
+	// OperatorAnd requires every Predicate to match.
+	OperatorAnd LogicalOperator = iota
+	// OperatorOr requires at least one Predicate to match.
+	OperatorOr
+)
+
+// Predicate tests a single tag key/value pair against an Instance's Tags.
+// An empty Value matches any tag with the given Key, regardless of its
+// value.
+type Predicate struct {
+	// Key is the tag key to look up.
+	Key string
+
+	// Value is the expected tag value. Empty matches any value.
+	Value string
+
+	// Negate inverts the match, turning the Predicate into a NOT
+	// condition.
+	Negate bool
+}
+
+// Match reports whether tags satisfy the Predicate.
+func (p Predicate) Match(tags []Tag) bool {
+	tag := LookForTagByKey(p.Key, tags)
+	matched := tag != nil && (p.Value == "" || tag.Value == p.Value)
+
+	if p.Negate {
+		return !matched
+	}
+	return matched
+}
+
+// Query is a set of Predicates combined by a LogicalOperator. It replaces
+// ad-hoc calls to LookForTagByKey with a reusable building block for
+// filtering Instances by their tags, as used by exclusion filters and the
+// gRPC ListInstancesRequest filter.
+type Query struct {
+	// Operator combines Predicates. Defaults to OperatorAnd.
+	Operator LogicalOperator
+
+	// Predicates is the set of conditions to evaluate. An empty Query
+	// matches every Instance.
+	Predicates []Predicate
+}
+
+// Match reports whether tags satisfy the Query.
+func (q Query) Match(tags []Tag) bool {
+	if len(q.Predicates) == 0 {
+		return true
+	}
+
+	switch q.Operator {
+	case OperatorOr:
+		for _, p := range q.Predicates {
+			if p.Match(tags) {
+				return true
+			}
+		}
+		return false
+	default: // OperatorAnd
+		for _, p := range q.Predicates {
+			if !p.Match(tags) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// FilterInstances returns the subset of instances whose Tags satisfy query.
+func FilterInstances(instances []Instance, query Query) []Instance {
+	filtered := make([]Instance, 0, len(instances))
+	for _, instance := range instances {
+		if query.Match(instance.Tags) {
+			filtered = append(filtered, instance)
+		}
+	}
+	return filtered
+}
+
+// NormalizeTags returns the subset of tags whose Key is in trackedKeys. An
+// empty trackedKeys tracks every tag unchanged.
+func NormalizeTags(tags []Tag, trackedKeys []string) []Tag {
+	if len(trackedKeys) == 0 {
+		return tags
+	}
+
+	tracked := make(map[string]struct{}, len(trackedKeys))
+	for _, key := range trackedKeys {
+		tracked[key] = struct{}{}
+	}
+
+	normalized := make([]Tag, 0, len(tags))
+	for _, tag := range tags {
+		if _, ok := tracked[tag.Key]; ok {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,162 @@
+package inventory
+
+import "testing"
+
+func TestGetClusterNameFromTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider Provider
+		tags     []Tag
+		want     string
+	}{
+		{
+			name:     "AWS openshift-installer tag",
+			provider: AWSProvider,
+			tags:     []Tag{{Key: "kubernetes.io/cluster/my-cluster-ab1cd", Value: "owned"}},
+			want:     "my-cluster",
+		},
+		{
+			name:     "GCP openshift-installer label",
+			provider: GCPProvider,
+			tags:     []Tag{{Key: "kubernetes-io-cluster-my-cluster-ab1cd", Value: "owned"}},
+			want:     "my-cluster",
+		},
+		{
+			name:     "Azure openshift-installer tag",
+			provider: AzureProvider,
+			tags:     []Tag{{Key: "kubernetes.io_cluster.my-cluster-ab1cd", Value: "owned"}},
+			want:     "my-cluster-ab1cd",
+		},
+		{
+			name:     "Azure cluster-api-provider-azure tag",
+			provider: AzureProvider,
+			tags:     []Tag{{Key: "sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster", Value: "owned"}},
+			want:     "my-cluster",
+		},
+		{
+			name:     "no cluster tag",
+			provider: AWSProvider,
+			tags:     []Tag{{Key: "Name", Value: "instance-1"}},
+			want:     unknownClusterNameCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetClusterNameFromTags(tt.provider, tt.tags); got != tt.want {
+				t.Errorf("GetClusterNameFromTags() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetInfraIDFromTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider Provider
+		tags     []Tag
+		want     string
+	}{
+		{
+			name:     "AWS openshift-installer tag",
+			provider: AWSProvider,
+			tags:     []Tag{{Key: "kubernetes.io/cluster/my-cluster-ab1cd", Value: "owned"}},
+			want:     "ab1cd",
+		},
+		{
+			name:     "GCP openshift-installer label",
+			provider: GCPProvider,
+			tags:     []Tag{{Key: "kubernetes-io-cluster-my-cluster-ab1cd", Value: "owned"}},
+			want:     "ab1cd",
+		},
+		{
+			name:     "Azure has no separate infra ID",
+			provider: AzureProvider,
+			tags:     []Tag{{Key: "kubernetes.io_cluster.my-cluster-ab1cd", Value: "owned"}},
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetInfraIDFromTags(tt.provider, tt.tags); got != tt.want {
+				t.Errorf("GetInfraIDFromTags() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetOwnerFromTags(t *testing.T) {
+	tags := []Tag{{Key: "Owner", Value: "jdoe"}}
+	if got := GetOwnerFromTags(tags); got != "jdoe" {
+		t.Errorf("GetOwnerFromTags() = %q, want %q", got, "jdoe")
+	}
+
+	if got := GetOwnerFromTags(nil); got != "" {
+		t.Errorf("GetOwnerFromTags() = %q, want empty string", got)
+	}
+}
+
+func TestQueryMatch(t *testing.T) {
+	tags := []Tag{
+		{Key: "cluster-iq.io/ignore", Value: "true"},
+		{Key: "Environment", Value: "production"},
+	}
+
+	tests := []struct {
+		name  string
+		query Query
+		want  bool
+	}{
+		{
+			name:  "AND matches when every predicate matches",
+			query: Query{Operator: OperatorAnd, Predicates: []Predicate{{Key: "cluster-iq.io/ignore", Value: "true"}, {Key: "Environment", Value: "production"}}},
+			want:  true,
+		},
+		{
+			name:  "AND fails when one predicate does not match",
+			query: Query{Operator: OperatorAnd, Predicates: []Predicate{{Key: "cluster-iq.io/ignore", Value: "true"}, {Key: "Environment", Value: "staging"}}},
+			want:  false,
+		},
+		{
+			name:  "OR matches when any predicate matches",
+			query: Query{Operator: OperatorOr, Predicates: []Predicate{{Key: "Environment", Value: "staging"}, {Key: "Environment", Value: "production"}}},
+			want:  true,
+		},
+		{
+			name:  "NOT inverts the match",
+			query: Query{Predicates: []Predicate{{Key: "cluster-iq.io/ignore", Value: "true", Negate: true}}},
+			want:  false,
+		},
+		{
+			name:  "empty query matches everything",
+			query: Query{},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.Match(tags); got != tt.want {
+				t.Errorf("Query.Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags := []Tag{
+		{Key: "Name", Value: "instance-1"},
+		{Key: "Owner", Value: "jdoe"},
+		{Key: "Environment", Value: "production"},
+	}
+
+	normalized := NormalizeTags(tags, []string{"Owner"})
+	if len(normalized) != 1 || normalized[0].Key != "Owner" {
+		t.Errorf("NormalizeTags() = %v, want only the Owner tag", normalized)
+	}
+
+	if got := NormalizeTags(tags, nil); len(got) != len(tags) {
+		t.Errorf("NormalizeTags() with no tracked keys = %v, want every tag untouched", got)
+	}
+}
@@ -0,0 +1,207 @@
+// Package job implements a small cron-style scheduler used by the ClusterIQ
+// Agent to run periodic background work (inventory sync, cluster
+// reconciliation, credentials refresh) without overlapping runs of the same
+// job.
+package job
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Status describes the current state of a Job.
+type Status string
+
+const (
+	// StatusIdle means the Job is waiting for its next scheduled or
+	// triggered run.
+	StatusIdle Status = "idle"
+	// StatusRunning means the Job's Func is currently executing.
+	StatusRunning Status = "running"
+	// StatusFailed means the Job's last run returned an error.
+	StatusFailed Status = "failed"
+)
+
+// Func is the work a Job performs on every run.
+type Func func(ctx context.Context) error
+
+// Job is a single unit of periodic work managed by a Scheduler.
+type Job struct {
+	// Name identifies the Job, e.g. "InventorySync/my-account".
+	Name string
+	// Interval is how often the Job runs when not triggered ad hoc.
+	Interval time.Duration
+	// Jitter adds a random delay in [0, Jitter) to every scheduled run, so
+	// that Jobs registered at the same Interval don't all fire in lockstep.
+	Jitter time.Duration
+	// Run is the work performed on every execution of the Job.
+	Run Func
+
+	mu      sync.Mutex
+	status  Status
+	lastRun time.Time
+	lastErr error
+	running bool
+
+	trigger chan struct{}
+}
+
+// Snapshot is a point-in-time, concurrency-safe view of a Job's state.
+type Snapshot struct {
+	Name      string
+	Status    Status
+	LastRun   time.Time
+	LastError error
+}
+
+func newJob(name string, interval time.Duration, jitter time.Duration, fn Func) *Job {
+	return &Job{
+		Name:     name,
+		Interval: interval,
+		Jitter:   jitter,
+		Run:      fn,
+		status:   StatusIdle,
+		trigger:  make(chan struct{}, 1),
+	}
+}
+
+// snapshot returns a concurrency-safe copy of the Job's current state.
+func (j *Job) snapshot() Snapshot {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return Snapshot{Name: j.Name, Status: j.status, LastRun: j.lastRun, LastError: j.lastErr}
+}
+
+// runOnce executes the Job's Func, unless a previous run is still in
+// flight, in which case it is a no-op. This is the Job's singleflight
+// guard: it prevents the same Job from running two overlapping executions
+// when a run takes longer than its Interval.
+func (j *Job) runOnce(ctx context.Context) {
+	j.mu.Lock()
+	if j.running {
+		j.mu.Unlock()
+		return
+	}
+	j.running = true
+	j.status = StatusRunning
+	j.mu.Unlock()
+
+	err := j.Run(ctx)
+
+	j.mu.Lock()
+	j.running = false
+	j.lastRun = time.Now()
+	j.lastErr = err
+	if err != nil {
+		j.status = StatusFailed
+	} else {
+		j.status = StatusIdle
+	}
+	j.mu.Unlock()
+}
+
+// Scheduler runs a set of registered Jobs, each on its own Interval, until
+// its context is cancelled.
+type Scheduler struct {
+	mu   sync.Mutex
+	jobs map[string]*Job
+	wg   sync.WaitGroup
+}
+
+// NewScheduler returns an empty Scheduler. Jobs must be registered with
+// Register before calling Start.
+func NewScheduler() *Scheduler {
+	return &Scheduler{jobs: make(map[string]*Job)}
+}
+
+// Register adds a Job to the Scheduler. It must be called before Start;
+// Jobs registered after Start has run are not picked up.
+func (s *Scheduler) Register(name string, interval time.Duration, jitter time.Duration, fn Func) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[name] = newJob(name, interval, jitter, fn)
+}
+
+// Start launches one goroutine per registered Job and returns immediately.
+// Every goroutine stops, and Start's spawned work finishes draining, once
+// ctx is cancelled; use Wait to block until that has happened.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, j := range s.jobs {
+		j := j
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.run(ctx, j)
+		}()
+	}
+}
+
+// run is the Job's scheduling loop: it fires on Interval (plus Jitter), on
+// an ad-hoc TriggerJob call, or stops once ctx is cancelled.
+func (s *Scheduler) run(ctx context.Context, j *Job) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(j.Interval + jitter(j.Jitter)):
+			j.runOnce(ctx)
+		case <-j.trigger:
+			j.runOnce(ctx)
+		}
+	}
+}
+
+// jitter returns a random duration in [0, max). It returns 0 for a
+// non-positive max instead of panicking, since rand.Int63n does.
+func jitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// TriggerJob requests an immediate, out-of-band run of the named Job. It
+// returns an error if no Job with that name is registered. At most one
+// trigger is held per Job: a trigger sent while the Job is running starts
+// one more run once the current run finishes, and further triggers sent
+// while one is already pending are silently dropped.
+func (s *Scheduler) TriggerJob(name string) error {
+	s.mu.Lock()
+	j, ok := s.jobs[name]
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("unknown job %q", name)
+	}
+
+	select {
+	case j.trigger <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Status returns a snapshot of every registered Job's current state.
+func (s *Scheduler) Status() []Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshots := make([]Snapshot, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		snapshots = append(snapshots, j.snapshot())
+	}
+	return snapshots
+}
+
+// Wait blocks until every Job goroutine started by Start has returned,
+// i.e. until the Scheduler's context has been cancelled and any in-flight
+// run has drained. It is safe to call Wait before Start, in which case it
+// returns immediately.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
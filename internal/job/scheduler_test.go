@@ -0,0 +1,99 @@
+package job
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTriggerJobRunsImmediately(t *testing.T) {
+	s := NewScheduler()
+
+	var runs int32
+	s.Register("test-job", time.Hour, 0, func(ctx context.Context) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	if err := s.TriggerJob("test-job"); err != nil {
+		t.Fatalf("TriggerJob returned error: %v", err)
+	}
+
+	deadline := time.After(time.Second)
+	for atomic.LoadInt32(&runs) == 0 {
+		select {
+		case <-deadline:
+			t.Fatal("triggered job did not run within 1s")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestTriggerJobUnknownName(t *testing.T) {
+	s := NewScheduler()
+	if err := s.TriggerJob("does-not-exist"); err == nil {
+		t.Fatal("expected an error for an unregistered job name")
+	}
+}
+
+func TestRunOnceSkipsWhileRunning(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var runs int32
+
+	j := newJob("slow-job", time.Hour, 0, func(ctx context.Context) error {
+		atomic.AddInt32(&runs, 1)
+		close(started)
+		<-release
+		return nil
+	})
+
+	ctx := context.Background()
+	go j.runOnce(ctx)
+	<-started
+
+	// A second run while the first is still in flight must be a no-op.
+	j.runOnce(ctx)
+	close(release)
+
+	if got := atomic.LoadInt32(&runs); got != 1 {
+		t.Fatalf("expected exactly 1 run while the first was in flight, got %d", got)
+	}
+}
+
+func TestStatusReflectsLastRun(t *testing.T) {
+	s := NewScheduler()
+	s.Register("ok-job", time.Hour, 0, func(ctx context.Context) error { return nil })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	if err := s.TriggerJob("ok-job"); err != nil {
+		t.Fatalf("TriggerJob returned error: %v", err)
+	}
+
+	deadline := time.After(time.Second)
+	for {
+		snapshots := s.Status()
+		if len(snapshots) != 1 {
+			t.Fatalf("expected exactly 1 job snapshot, got %d", len(snapshots))
+		}
+		if !snapshots[0].LastRun.IsZero() {
+			if snapshots[0].Status != StatusIdle {
+				t.Fatalf("expected status %q after a successful run, got %q", StatusIdle, snapshots[0].Status)
+			}
+			return
+		}
+		select {
+		case <-deadline:
+			t.Fatal("job did not complete within 1s")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package profiler
+
+import (
+	"net/http"
+	"net/http/pprof"
+
+	"go.uber.org/zap"
+)
+
+// StartAdminServer starts an HTTP server on listenURL exposing net/http/pprof
+// handlers for ad-hoc debugging, separate from the Agent's own gRPC
+// listener. It returns immediately; serving happens in a background
+// goroutine, and any error is logged rather than returned, since the admin
+// endpoint is a debugging aid and must not affect the Agent's startup.
+func StartAdminServer(listenURL string, logger *zap.Logger) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	server := &http.Server{Addr: listenURL, Handler: mux}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("Admin pprof server stopped", zap.Error(err))
+		}
+	}()
+}
@@ -0,0 +1,165 @@
+// Package profiler implements an opt-in continuous profiling subsystem for
+// the ClusterIQ Agent, modeled after the Cloud Profiler client: a single
+// Start call spawns one background goroutine that periodically collects
+// CPU, heap, goroutine and mutex pprof profiles and uploads them to a
+// pluggable Sink.
+package profiler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	rpprof "runtime/pprof"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// defaultCPUProfileDuration is how long each CPU profile collection runs
+// for, out of every Config.Interval.
+const defaultCPUProfileDuration = 10 * time.Second
+
+// Config declares how the profiler collects and ships profiles.
+type Config struct {
+	// Enabled turns the profiler on. Start is a no-op when false.
+	Enabled bool
+
+	// Interval is how often a full round of CPU, heap, goroutine and mutex
+	// profiles is collected.
+	Interval time.Duration
+
+	// Sink receives every collected Profile.
+	Sink Sink
+
+	// Labels are attached to every collected Profile, e.g. version,
+	// commit, and the Agent's configured account names and providers.
+	Labels map[string]string
+
+	// Logger is used to report collection and upload failures. Required.
+	Logger *zap.Logger
+}
+
+var startOnce sync.Once
+
+// Start begins continuous profiling in a background goroutine, as
+// configured by cfg. It is safe to call exactly once; subsequent calls are
+// no-ops. Start returns immediately; profiling runs until ctx is done.
+func Start(ctx context.Context, cfg Config) {
+	if !cfg.Enabled {
+		return
+	}
+
+	startOnce.Do(func() {
+		runtime.SetMutexProfileFraction(1)
+		go run(ctx, cfg)
+	})
+}
+
+// run periodically collects and uploads a profiling round until ctx is
+// done. If cfg.Sink suggests a different interval for a round, that
+// suggestion replaces interval for subsequent rounds until another
+// suggestion supersedes it; a round without a suggestion keeps the current
+// interval.
+func run(ctx context.Context, cfg Config) {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	for {
+		suggested, err := collectRound(ctx, cfg)
+		if err != nil {
+			cfg.Logger.Error("Failed to collect profiling round", zap.Error(err))
+		}
+		if suggested > 0 {
+			interval = suggested
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// collectRound collects a CPU, heap, goroutine and mutex profile and
+// uploads each one to cfg.Sink. It returns the last server-suggested
+// interval returned by cfg.Sink across the round's uploads, or 0 if none
+// of them suggested one.
+func collectRound(ctx context.Context, cfg Config) (time.Duration, error) {
+	collectedAt := time.Now()
+	var suggested time.Duration
+
+	cpuProfile, err := collectCPUProfile(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cannot collect CPU profile: %w", err)
+	}
+	next, err := upload(ctx, cfg, "cpu", cpuProfile, collectedAt)
+	if err != nil {
+		return suggested, err
+	}
+	if next > 0 {
+		suggested = next
+	}
+
+	for _, name := range []string{"heap", "goroutine", "mutex"} {
+		data, err := collectNamedProfile(name)
+		if err != nil {
+			return suggested, fmt.Errorf("cannot collect %s profile: %w", name, err)
+		}
+		next, err := upload(ctx, cfg, name, data, collectedAt)
+		if err != nil {
+			return suggested, err
+		}
+		if next > 0 {
+			suggested = next
+		}
+	}
+
+	return suggested, nil
+}
+
+// collectCPUProfile runs a CPU profile for defaultCPUProfileDuration, or
+// until ctx is done, whichever comes first.
+func collectCPUProfile(ctx context.Context) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := rpprof.StartCPUProfile(&buf); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(defaultCPUProfileDuration):
+	}
+
+	rpprof.StopCPUProfile()
+	return buf.Bytes(), nil
+}
+
+// collectNamedProfile writes one of Go's built-in named profiles (heap,
+// goroutine, mutex, ...) to a buffer.
+func collectNamedProfile(name string) ([]byte, error) {
+	profile := rpprof.Lookup(name)
+	if profile == nil {
+		return nil, fmt.Errorf("unknown profile %q", name)
+	}
+
+	var buf bytes.Buffer
+	if err := profile.WriteTo(&buf, 0); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// upload sends a collected profile to cfg.Sink, tagged with cfg.Labels.
+func upload(ctx context.Context, cfg Config, profileType string, data []byte, collectedAt time.Time) (time.Duration, error) {
+	return cfg.Sink.Upload(ctx, Profile{
+		Type:        profileType,
+		Data:        data,
+		Labels:      cfg.Labels,
+		CollectedAt: collectedAt,
+	})
+}
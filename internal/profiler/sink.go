@@ -0,0 +1,34 @@
+package profiler
+
+import (
+	"context"
+	"time"
+)
+
+// Profile is a single collected pprof profile, tagged with Labels
+// identifying which Agent and build produced it.
+type Profile struct {
+	// Type identifies which profile this is: "cpu", "heap", "goroutine" or
+	// "mutex".
+	Type string
+
+	// Data is the raw pprof-encoded profile.
+	Data []byte
+
+	// Labels are attached to the upload, e.g. version, commit, and the
+	// Agent's configured account names and providers.
+	Labels map[string]string
+
+	// CollectedAt is when collection of this Profile started.
+	CollectedAt time.Time
+}
+
+// Sink receives collected Profiles. Implementations include a local
+// directory, an S3 bucket, and an HTTP endpoint.
+type Sink interface {
+	// Upload ships profile to the sink's destination. It returns a
+	// server-suggested interval to wait before the next profiling round, or
+	// 0 if the sink has no such suggestion, e.g. because it isn't backed by
+	// a remote collector that can return one.
+	Upload(ctx context.Context, profile Profile) (suggestedInterval time.Duration, err error)
+}
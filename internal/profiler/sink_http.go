@@ -0,0 +1,73 @@
+package profiler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// suggestedIntervalHeader is the response header a collector behind
+// HTTPSink may set to request a different profiling interval going
+// forward, as an integer number of seconds.
+const suggestedIntervalHeader = "X-ClusterIQ-Suggested-Interval-Seconds"
+
+// HTTPSink POSTs every Profile to a collector endpoint, with the profile's
+// Labels carried as query parameters.
+type HTTPSink struct {
+	client   *http.Client
+	endpoint string
+}
+
+// NewHTTPSink returns a Sink POSTing profiles to endpoint.
+func NewHTTPSink(client *http.Client, endpoint string) *HTTPSink {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPSink{client: client, endpoint: endpoint}
+}
+
+// Upload POSTs profile.Data to the sink's endpoint. If the collector
+// responds with suggestedIntervalHeader, Upload returns it as the next
+// profiling interval to use.
+func (s *HTTPSink) Upload(ctx context.Context, profile Profile) (time.Duration, error) {
+	query := url.Values{"type": {profile.Type}}
+	for key, value := range profile.Labels {
+		query.Set(key, value)
+	}
+	reqURL := fmt.Sprintf("%s?%s", s.endpoint, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewReader(profile.Data))
+	if err != nil {
+		return 0, fmt.Errorf("cannot build profile upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("cannot upload profile to %q: %w", s.endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return 0, fmt.Errorf("profile upload to %q failed with status %s", s.endpoint, resp.Status)
+	}
+
+	return suggestedInterval(resp.Header.Get(suggestedIntervalHeader)), nil
+}
+
+// suggestedInterval parses the value of suggestedIntervalHeader, returning
+// 0 if it's absent or malformed.
+func suggestedInterval(seconds string) time.Duration {
+	if seconds == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(seconds)
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return time.Duration(n) * time.Second
+}
@@ -0,0 +1,34 @@
+package profiler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LocalDirSink writes every Profile to its own file under Dir, for ad-hoc
+// debugging on a single host.
+type LocalDirSink struct {
+	// Dir is the directory profiles are written to. It must already exist.
+	Dir string
+}
+
+// NewLocalDirSink returns a Sink writing profiles under dir.
+func NewLocalDirSink(dir string) *LocalDirSink {
+	return &LocalDirSink{Dir: dir}
+}
+
+// Upload writes profile to a file named `<type>-<unix-nano>.pprof` under
+// the sink's Dir. It never suggests a different interval, since there's no
+// remote collector to suggest one.
+func (s *LocalDirSink) Upload(ctx context.Context, profile Profile) (time.Duration, error) {
+	name := fmt.Sprintf("%s-%d.pprof", profile.Type, profile.CollectedAt.UnixNano())
+	path := filepath.Join(s.Dir, name)
+
+	if err := os.WriteFile(path, profile.Data, 0o644); err != nil {
+		return 0, fmt.Errorf("cannot write profile to %q: %w", path, err)
+	}
+	return 0, nil
+}
@@ -0,0 +1,41 @@
+package profiler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// S3Sink uploads every Profile as an object in an S3 bucket.
+type S3Sink struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// NewS3Sink returns a Sink uploading profiles to bucket, with object keys
+// under prefix.
+func NewS3Sink(client *s3.Client, bucket string, prefix string) *S3Sink {
+	return &S3Sink{client: client, bucket: bucket, prefix: prefix}
+}
+
+// Upload puts profile under `<prefix>/<type>-<unix-nano>.pprof` in the
+// sink's bucket. It never suggests a different interval, since S3 has no
+// notion of a profiling schedule to suggest one.
+func (s *S3Sink) Upload(ctx context.Context, profile Profile) (time.Duration, error) {
+	key := fmt.Sprintf("%s/%s-%d.pprof", s.prefix, profile.Type, profile.CollectedAt.UnixNano())
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(profile.Data),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("cannot upload profile to s3://%s/%s: %w", s.bucket, key, err)
+	}
+	return 0, nil
+}